@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nuonco/nuon-ext-api/internal/client"
+)
+
+func checkConnectionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check-connection",
+		Short: "Verify transport settings (CA, client cert, proxy, unix socket) without calling the API",
+		Long: `Dial the configured API endpoint and, for https URLs, complete a TLS handshake
+using the configured CA bundle, client certificate and verification settings.
+No HTTP request is sent, so no API token is needed.
+
+Transport settings (environment):
+  NUON_API_URL            https://..., http://... or unix:///path/to.sock
+  NUON_API_CA_FILE        PEM bundle trusted in addition to the system roots
+  NUON_API_CLIENT_CERT    PEM client certificate for mTLS
+  NUON_API_CLIENT_KEY     PEM private key for the client certificate
+  NUON_API_PROXY          explicit proxy URL (HTTPS_PROXY/NO_PROXY apply otherwise)
+  NUON_API_INSECURE=true  skip certificate verification (same as --insecure)`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if insecure, _ := cmd.Flags().GetBool("insecure"); insecure {
+				cfg.Insecure = true
+			}
+			if cfg.Insecure {
+				warnInsecure()
+			}
+
+			report, err := client.CheckConnection(cmd.Context(), cfg)
+			if report != nil {
+				printConnectionReport(report)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Println("OK")
+			return nil
+		},
+	}
+}
+
+func printConnectionReport(r *client.ConnectionReport) {
+	fmt.Printf("API URL:      %s\n", cfg.APIURL)
+	fmt.Printf("Dial:         %s %s\n", r.Network, r.Address)
+	if r.Proxy != "" {
+		via := ""
+		if r.TLS {
+			via = " (CONNECT tunnel)"
+		}
+		fmt.Printf("Proxy:        %s%s\n", r.Proxy, via)
+	}
+	if cfg.CAFile != "" {
+		fmt.Printf("CA file:      %s\n", cfg.CAFile)
+	}
+	if r.ClientCert {
+		fmt.Printf("Client cert:  %s\n", cfg.ClientCert)
+	}
+	if r.TLS {
+		verify := "verified"
+		if r.Insecure {
+			verify = "NOT verified (--insecure)"
+		}
+		fmt.Printf("TLS:          %s, %s for %s\n", r.TLSVersion, verify, r.ServerName)
+		fmt.Printf("Server cert:  %s\n", r.PeerSubject)
+		fmt.Printf("Issuer:       %s\n", r.PeerIssuer)
+		fmt.Printf("Expires:      %s\n", r.PeerExpiry.Format(time.RFC3339))
+	}
+	if r.Elapsed > 0 {
+		fmt.Printf("Connected in: %s\n", r.Elapsed.Round(time.Millisecond))
+	}
+}
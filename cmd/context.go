@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nuonco/nuon-ext-api/internal/apicontext"
+	"github.com/nuonco/nuon-ext-api/internal/client"
+	"github.com/nuonco/nuon-ext-api/internal/config"
+	"github.com/nuonco/nuon-ext-api/internal/resolve"
+)
+
+// contextOrder lists the params that scope others; they are resolved and
+// shown first.
+var contextOrder = []string{"org_id", "app_id", "install_id"}
+
+func contextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "context",
+		Short: "Save default org, app, install and other path params per profile",
+		Long: `Save default values for path placeholders so they need not be exported in
+every shell. Saved values apply after -p flags and NUON_<NAME> env vars but
+before the interactive selector. The org also scopes requests when
+NUON_ORG_ID is unset.
+
+Keys are param names with or without the _id suffix. Names must match one
+resource and are saved as its ID. Contexts are kept per profile (--profile or
+NUON_API_PROFILE, default "default") in NUON_EXT_DIR/context.json.
+
+Examples:
+  nuon api context set org=acme app=my-app install=acme-prod
+  nuon api context show
+  nuon api context clear install
+  nuon api context set --profile staging app=my-app-staging`,
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "set key=value...",
+		Short: "Save default values for path params",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  runContextSet,
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "show",
+		Short: "Print the saved context of the active profile",
+		Args:  cobra.NoArgs,
+		RunE:  runContextShow,
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "clear [key...]",
+		Short: "Remove saved values, or the whole profile when no keys are given",
+		RunE:  runContextClear,
+	})
+	return cmd
+}
+
+func contextPath() string {
+	return filepath.Join(cfg.StateDir(), "context.json")
+}
+
+// loadContext selects the profile and reads its saved values into cfg.
+func loadContext(cmd *cobra.Command) {
+	if profile, _ := cmd.Flags().GetString("profile"); profile != "" {
+		cfg.Profile = profile
+	}
+	if cfg.Profile == "" {
+		cfg.Profile = apicontext.DefaultProfile
+	}
+
+	f, err := apicontext.Load(contextPath())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: ignoring saved context: %v\n", err)
+		return
+	}
+	cfg.Context = f.Get(cfg.Profile)
+}
+
+func runContextSet(cmd *cobra.Command, args []string) (err error) {
+	values, err := config.ParseParams(args)
+	if err != nil {
+		return err
+	}
+	params := make(map[string]string, len(values))
+	for k, v := range values {
+		params[apicontext.ParamName(k)] = v
+	}
+
+	c, err := client.New(cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := c.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	// Resolve names in scope order, so an app name narrows the install
+	// lookup and a new org scopes the requests after it.
+	prefixes := loadIDPrefixes()
+	resolved := make(map[string]string)
+	for _, name := range contextKeys(params) {
+		id, err := resolve.ResolveName(cmd.Context(), api, name, params[name], prefixes, cfg, c, resolved)
+		if err != nil {
+			return err
+		}
+		resolved[name] = id
+		cfg.Context = mergeContext(cfg.Context, resolved)
+
+		// Later lookups run in the new org, unless NUON_ORG_ID overrides it.
+		if name == "org_id" && cfg.OrgID == "" {
+			next, err := client.New(cfg)
+			if err != nil {
+				return err
+			}
+			if err := c.Close(); err != nil {
+				next.Close()
+				return err
+			}
+			c = next
+		}
+	}
+
+	f, err := apicontext.Load(contextPath())
+	if err != nil {
+		return err
+	}
+	f.Set(cfg.Profile, resolved)
+	if err := f.Save(); err != nil {
+		return err
+	}
+	printContext(f.Get(cfg.Profile))
+	return nil
+}
+
+func runContextShow(cmd *cobra.Command, args []string) error {
+	printContext(cfg.Context)
+	return nil
+}
+
+func runContextClear(cmd *cobra.Command, args []string) error {
+	f, err := apicontext.Load(contextPath())
+	if err != nil {
+		return err
+	}
+	params := make([]string, len(args))
+	for i, k := range args {
+		params[i] = apicontext.ParamName(k)
+	}
+	f.Clear(cfg.Profile, params...)
+	if err := f.Save(); err != nil {
+		return err
+	}
+	printContext(f.Get(cfg.Profile))
+	return nil
+}
+
+// printContext lists the profile's values, noting those an env var overrides.
+func printContext(values map[string]string) {
+	if len(values) == 0 {
+		fmt.Printf("No context saved for profile %q.\n", cfg.Profile)
+		return
+	}
+	fmt.Printf("Context (profile %q):\n", cfg.Profile)
+	for _, name := range contextKeys(values) {
+		line := fmt.Sprintf("  {%s} = %s", name, values[name])
+		if config.EnvParam(name) != "" {
+			line += fmt.Sprintf("  (overridden by %s)", config.ParamEnvVar(name))
+		}
+		fmt.Println(line)
+	}
+}
+
+// contextKeys returns the names in values, those in contextOrder first.
+func contextKeys(values map[string]string) []string {
+	var keys []string
+	for _, name := range contextOrder {
+		if _, ok := values[name]; ok {
+			keys = append(keys, name)
+		}
+	}
+	var rest []string
+	for name := range values {
+		if !contains(contextOrder, name) {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	return append(keys, rest...)
+}
+
+func mergeContext(base, values map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(values))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range values {
+		merged[k] = v
+	}
+	return merged
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nuonco/nuon-ext-api/internal/debug"
+	"github.com/nuonco/nuon-ext-api/internal/dispatch"
+	"github.com/nuonco/nuon-ext-api/internal/idprefix"
+)
+
+func loadIDPrefixes() *idprefix.Store {
+	return idprefix.Load(filepath.Join(cfg.StateDir(), "id-prefixes.json"), cfg.IDPrefixes)
+}
+
+// checkIDs warns about path params whose IDs carry another param's prefix,
+// or fails with --strict-ids.
+func checkIDs(prefixes *idprefix.Store, req *dispatch.Request) error {
+	values := make(map[string]string, len(req.Params))
+	for _, p := range req.Params {
+		values[p.Name] = p.Value
+	}
+	mismatches := prefixes.Check(api, req.Route, values)
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	lines := make([]string, len(mismatches))
+	for i, m := range mismatches {
+		lines[i] = m.String()
+	}
+	if cfg.StrictIDs {
+		return fmt.Errorf("ID prefix check failed for %s:\n%s", req.Route.DisplayName(), strings.Join(lines, "\n"))
+	}
+	for _, line := range lines {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", line)
+	}
+	return nil
+}
+
+// learnIDs records the prefixes of the IDs in a successful request's path and
+// response body.
+func learnIDs(prefixes *idprefix.Store, req *dispatch.Request, body []byte) {
+	for _, p := range req.Params {
+		prefixes.Learn(p.Name, p.Value)
+	}
+	prefixes.LearnBody(body, idprefix.ParamNames(api), idprefix.IDParam(api, req.Route))
+}
+
+func saveIDPrefixes(prefixes *idprefix.Store) {
+	if err := prefixes.Save(); err != nil {
+		debug.Log("idprefix: not saved: %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nuonco/nuon-ext-api/internal/mock"
+)
+
+func mockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mock",
+		Short: "Serve a local mock of the Nuon API generated from the embedded spec",
+		Long: `Serve every route in the embedded API spec from a local HTTP server.
+
+Responses are generated from the spec's definitions, request bodies are
+validated against their schemas, and fixture files named <operationId>.json
+in --fixtures replace generated responses.
+
+Point the extension (or your own scripts) at the mock with NUON_API_URL:
+  nuon api mock --port 8080 --fixtures ./fixtures
+  NUON_API_URL=http://127.0.0.1:8080 nuon api /v1/apps`,
+		Args: cobra.NoArgs,
+		RunE: runMock,
+	}
+
+	cmd.Flags().Int("port", 8080, "Port to listen on")
+	cmd.Flags().String("host", "127.0.0.1", "Interface to listen on")
+	cmd.Flags().String("fixtures", "", "Directory of <operationId>.json response overrides")
+
+	return cmd
+}
+
+func runMock(cmd *cobra.Command, args []string) error {
+	port, _ := cmd.Flags().GetInt("port")
+	host, _ := cmd.Flags().GetString("host")
+	fixtures, _ := cmd.Flags().GetString("fixtures")
+
+	if fixtures != "" {
+		if info, err := os.Stat(fixtures); err != nil || !info.IsDir() {
+			return fmt.Errorf("fixtures directory not found: %s", fixtures)
+		}
+	}
+
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", addr, err)
+	}
+
+	srv := &http.Server{
+		Handler:           logRequests(mock.NewHandler(api, mock.Options{FixturesDir: fixtures})),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	fmt.Fprintf(os.Stderr, "Mock Nuon API v%s (%d routes) listening on http://%s\n", api.Version, len(api.Routes), ln.Addr())
+	fmt.Fprintf(os.Stderr, "  export NUON_API_URL=http://%s\n", ln.Addr())
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- srv.Serve(ln) }()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-cmd.Context().Done():
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+}
+
+// logRequests writes one line per request to stderr.
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next.ServeHTTP(rec, r)
+		fmt.Fprintf(os.Stderr, "%s %s %d %s\n", r.Method, r.URL.RequestURI(), rec.status, time.Since(start).Round(time.Microsecond))
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nuonco/nuon-ext-api/internal/client"
+	"github.com/nuonco/nuon-ext-api/internal/dispatch"
+	"github.com/nuonco/nuon-ext-api/internal/idprefix"
+	"github.com/nuonco/nuon-ext-api/internal/output"
+)
+
+// multiResult is one response of a --multi fan-out, tagged with the path
+// params it was sent with.
+type multiResult struct {
+	Params   map[string]string `json:"params"`
+	Status   int               `json:"status,omitempty"`
+	Response json.RawMessage   `json:"response,omitempty"`
+	Error    string            `json:"error,omitempty"`
+}
+
+// checkMultiFlags rejects flags that need a single response, before the
+// selector opens.
+func checkMultiFlags(cmd *cobra.Command, outOpts output.Options) error {
+	if paginate, _ := cmd.Flags().GetBool("paginate"); paginate {
+		return fmt.Errorf("--multi cannot be combined with --paginate")
+	}
+	if outOpts.Include || outOpts.StatusOnly || len(outOpts.Headers) > 0 {
+		return fmt.Errorf("--multi cannot be combined with --include, --status or --header-out")
+	}
+	if stream, _ := cmd.Flags().GetBool("stream"); stream && outOpts.OutputFile != "" {
+		return fmt.Errorf("--stream cannot be combined with --output")
+	}
+	return nil
+}
+
+// runMulti sends one request per value picked with --multi, at most
+// --concurrency at a time. Responses are printed as one JSON array in pick
+// order or, with --stream, as NDJSON as they complete. A summary of failures
+// goes to stderr, and any failure fails the command; if every failure was a
+// timeout, the error matches context.DeadlineExceeded so the exit code is 124.
+func runMulti(cmd *cobra.Command, c *client.Client, reqs []*dispatch.Request, queryParams []client.QueryParam, outOpts output.Options, prefixes *idprefix.Store) error {
+	stream, _ := cmd.Flags().GetBool("stream")
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+
+	results := make([]json.RawMessage, len(reqs))
+	var failures []string
+	timeouts := 0
+	var writeErr error
+	dispatch.FanOut(cmd.Context(), c, reqs, queryParams, concurrency, func(o dispatch.Outcome) {
+		r, failure := tagOutcome(o)
+		if failure != "" {
+			failures = append(failures, failure)
+			if errors.Is(o.Err, context.DeadlineExceeded) {
+				timeouts++
+			}
+		} else {
+			learnIDs(prefixes, o.Request, o.Response.Body)
+		}
+
+		data, err := json.Marshal(r)
+		if err != nil {
+			writeErr = fmt.Errorf("encoding result: %w", err)
+			return
+		}
+		results[o.Index] = data
+		if stream && writeErr == nil {
+			writeErr = output.PrintNDJSON([]json.RawMessage{data}, outOpts)
+		}
+	})
+	if writeErr != nil {
+		return writeErr
+	}
+
+	if !stream {
+		if err := output.PrintItems(results, outOpts); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "%d succeeded, %d failed\n", len(reqs)-len(failures), len(failures))
+	for _, f := range failures {
+		fmt.Fprintf(os.Stderr, "  %s\n", f)
+	}
+	switch {
+	case len(failures) > 0 && timeouts == len(failures):
+		return fmt.Errorf("%d of %d requests timed out: %w", timeouts, len(reqs), context.DeadlineExceeded)
+	case len(failures) > 0:
+		return fmt.Errorf("%d of %d requests failed", len(failures), len(reqs))
+	}
+	return nil
+}
+
+// tagOutcome converts o into its tagged result. It also returns a line
+// describing the failure, or "" if the request succeeded.
+func tagOutcome(o dispatch.Outcome) (multiResult, string) {
+	r := multiResult{Params: make(map[string]string, len(o.Request.Params))}
+	var tags []string
+	for _, p := range o.Request.Params {
+		r.Params[p.Name] = p.Value
+		tags = append(tags, fmt.Sprintf("{%s} = %s", p.Name, p.Value))
+	}
+	label := strings.Join(tags, ", ")
+
+	if o.Err != nil {
+		r.Error = o.Err.Error()
+		return r, label + ": " + r.Error
+	}
+
+	r.Status = o.Response.StatusCode
+	switch body := o.Response.Body; {
+	case len(body) == 0:
+	case json.Valid(body):
+		r.Response = body
+	default:
+		// Text and other non-JSON bodies are embedded as a string.
+		r.Response, _ = json.Marshal(string(body))
+	}
+	if r.Status >= 400 {
+		r.Error = fmt.Sprintf("HTTP %d", r.Status)
+		return r, label + ": " + r.Error
+	}
+	return r, ""
+}
@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nuonco/nuon-ext-api/internal/client"
+	"github.com/nuonco/nuon-ext-api/internal/config"
+	"github.com/nuonco/nuon-ext-api/internal/dispatch"
+	"github.com/nuonco/nuon-ext-api/internal/idprefix"
+	"github.com/nuonco/nuon-ext-api/internal/output"
+	"github.com/nuonco/nuon-ext-api/internal/resolve"
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+func TestTagOutcome(t *testing.T) {
+	req := &dispatch.Request{Params: []resolve.Param{{Name: "app_id", Value: "app_1"}, {Name: "install_id", Value: "ins_1"}}}
+	tests := []struct {
+		name     string
+		outcome  dispatch.Outcome
+		response string
+		failure  string
+	}{
+		{
+			name:     "json",
+			outcome:  dispatch.Outcome{Request: req, Response: &client.Response{StatusCode: 200, Body: []byte(`{"id":"ins_1"}`)}},
+			response: `{"id":"ins_1"}`,
+		},
+		{
+			name:     "text is embedded as a string",
+			outcome:  dispatch.Outcome{Request: req, Response: &client.Response{StatusCode: 200, Body: []byte("line 1\nline 2")}},
+			response: `"line 1\nline 2"`,
+		},
+		{
+			name:    "empty body",
+			outcome: dispatch.Outcome{Request: req, Response: &client.Response{StatusCode: 204}},
+		},
+		{
+			name:     "http error",
+			outcome:  dispatch.Outcome{Request: req, Response: &client.Response{StatusCode: 404, Body: []byte(`{"error":"not found"}`)}},
+			response: `{"error":"not found"}`,
+			failure:  "{app_id} = app_1, {install_id} = ins_1: HTTP 404",
+		},
+		{
+			name:    "request error",
+			outcome: dispatch.Outcome{Request: req, Err: errors.New("connection refused")},
+			failure: "{app_id} = app_1, {install_id} = ins_1: connection refused",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, failure := tagOutcome(tt.outcome)
+			if failure != tt.failure {
+				t.Fatalf("expected failure %q, got %q", tt.failure, failure)
+			}
+			if string(r.Response) != tt.response {
+				t.Fatalf("expected response %s, got %s", tt.response, r.Response)
+			}
+			if r.Params["app_id"] != "app_1" || r.Params["install_id"] != "ins_1" {
+				t.Fatalf("expected the result tagged with its params, got %v", r.Params)
+			}
+		})
+	}
+}
+
+func TestRunMultiPrintsResultsInPickOrder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/installs/ins_1":
+			// Finish last, so completion order differs from pick order.
+			time.Sleep(50 * time.Millisecond)
+			w.Write([]byte(`{"id":"ins_1"}`))
+		case "/v1/installs/ins_2":
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte("plain text"))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":"boom"}`))
+		}
+	}))
+	defer srv.Close()
+
+	stdout, stderr, err := captureMulti(t, srv.URL, 0, false, "ins_1", "ins_2", "ins_3")
+	if err == nil || err.Error() != "1 of 3 requests failed" {
+		t.Fatalf("expected the failure to fail the command, got %v", err)
+	}
+	if code := exitCode(context.Background(), err); code != exitError {
+		t.Fatalf("expected exit code %d, got %d", exitError, code)
+	}
+
+	var results []multiResult
+	if jsonErr := json.Unmarshal([]byte(stdout), &results); jsonErr != nil {
+		t.Fatalf("expected a JSON array, got %q: %v", stdout, jsonErr)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	for i, id := range []string{"ins_1", "ins_2", "ins_3"} {
+		if results[i].Params["install_id"] != id {
+			t.Fatalf("expected result %d for %s, got %v", i, id, results[i].Params)
+		}
+	}
+	if string(results[1].Response) != `"plain text"` || results[2].Status != 500 || results[2].Error != "HTTP 500" {
+		t.Fatalf("unexpected results %+v", results)
+	}
+
+	if !strings.Contains(stderr, "2 succeeded, 1 failed") || !strings.Contains(stderr, "{install_id} = ins_3: HTTP 500") {
+		t.Fatalf("expected a failure summary on stderr, got %q", stderr)
+	}
+}
+
+func TestRunMultiStreamsAsCompleted(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	stdout, stderr, err := captureMulti(t, srv.URL, 0, true, "ins_1", "ins_2")
+	if err != nil {
+		t.Fatalf("runMulti() returned error: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(stdout), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[0], `{"params":`) {
+		t.Fatalf("expected one NDJSON line per request, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "2 succeeded, 0 failed") {
+		t.Fatalf("expected a summary on stderr, got %q", stderr)
+	}
+}
+
+func TestRunMultiTimeoutsExitWithTimeoutCode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	_, _, err := captureMulti(t, srv.URL, 20*time.Millisecond, false, "ins_1", "ins_2")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+	if code := exitCode(context.Background(), err); code != exitTimeout {
+		t.Fatalf("expected exit code %d, got %d", exitTimeout, code)
+	}
+}
+
+// captureMulti runs runMulti for GET /v1/installs/{install_id} with each id
+// and returns what it wrote to stdout and stderr.
+func captureMulti(t *testing.T, apiURL string, timeout time.Duration, stream bool, ids ...string) (string, string, error) {
+	t.Helper()
+	if api == nil {
+		api = &spec.API{}
+	}
+
+	c, err := client.New(&config.Config{APIURL: apiURL, Timeout: timeout})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	var reqs []*dispatch.Request
+	for _, id := range ids {
+		reqs = append(reqs, &dispatch.Request{
+			Route:  spec.Route{Method: "GET", Path: "/v1/installs/{install_id}"},
+			Method: "GET",
+			Path:   "/v1/installs/" + id,
+			Params: []resolve.Param{{Name: "install_id", Value: id}},
+		})
+	}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool("stream", stream, "")
+	cmd.Flags().Int("concurrency", len(ids), "")
+	cmd.SetContext(context.Background())
+	prefixes := idprefix.Load(t.TempDir()+"/id-prefixes.json", nil)
+
+	var runErr error
+	stdout, stderr := capture(t, func() {
+		runErr = runMulti(cmd, c, reqs, nil, output.Options{Raw: true}, prefixes)
+	})
+	return stdout, stderr, runErr
+}
+
+// capture returns what fn writes to os.Stdout and os.Stderr.
+func capture(t *testing.T, fn func()) (string, string) {
+	t.Helper()
+	read := func(f **os.File) func() string {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		orig := *f
+		*f = w
+		done := make(chan string)
+		go func() {
+			data, _ := io.ReadAll(r)
+			done <- string(data)
+		}()
+		return func() string {
+			*f = orig
+			w.Close()
+			return <-done
+		}
+	}
+	stopOut := read(&os.Stdout)
+	stopErr := read(&os.Stderr)
+	fn()
+	return stopOut(), stopErr()
+}
@@ -1,18 +1,28 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"strconv"
 	"strings"
+	"syscall"
 
 	"github.com/atotto/clipboard"
 	"github.com/spf13/cobra"
 
 	"github.com/nuonco/nuon-ext-api/internal/client"
 	"github.com/nuonco/nuon-ext-api/internal/config"
+	"github.com/nuonco/nuon-ext-api/internal/debug"
 	"github.com/nuonco/nuon-ext-api/internal/dispatch"
+	"github.com/nuonco/nuon-ext-api/internal/har"
+	"github.com/nuonco/nuon-ext-api/internal/idprefix"
 	"github.com/nuonco/nuon-ext-api/internal/output"
 	"github.com/nuonco/nuon-ext-api/internal/pkg/tui/browser"
+	"github.com/nuonco/nuon-ext-api/internal/pkg/tui/selector"
 	"github.com/nuonco/nuon-ext-api/internal/spec"
 )
 
@@ -24,8 +34,18 @@ var (
 	BuildVersion = "dev"
 )
 
+// Exit codes. Signals follow the shell convention of 128+signal number and
+// timeouts mirror coreutils timeout(1) so automation can tell them apart.
+const (
+	exitError       = 1
+	exitTimeout     = 124
+	exitInterrupted = 130 // SIGINT
+	exitTerminated  = 143 // SIGTERM
+)
+
 func Execute() {
 	cfg = config.Load()
+	har.CreatorVersion = BuildVersion
 
 	apiVersion := "unknown"
 	parsedAPI, err := spec.Parse()
@@ -50,8 +70,13 @@ Prerequisites:
 
 Path placeholders ({...}):
   - If a placeholder remains in the path (for example {workflow_id}), this extension tries to resolve it.
-  - Resolution order: config/env -> interactive selector.
+  - Resolution order: value in the path -> -p name=value -> NUON_<NAME> env var -> saved context
+    ("nuon api context set app=...") -> interactive selector.
+    Any placeholder works, e.g. NUON_WORKFLOW_ID or -p workflow_id=... for {workflow_id}.
   - In non-interactive environments, pass concrete IDs to avoid selector prompts/failures.
+  - --dry-run prints the resolved request and the source of each value without sending it.
+  - --multi lets the selector pick several values (space toggles, a picks all filtered) and
+    runs the request once per value, printing the responses tagged with their params.
 
 The HTTP method is inferred from the request:
   - No payload: GET
@@ -60,11 +85,20 @@ The HTTP method is inferred from the request:
 Override the method with -X:
   nuon api -X DELETE /v1/apps/{app_id}
 
+Timeouts and cancellation:
+  - Each HTTP request is bounded by --timeout (or NUON_API_TIMEOUT), default 60s. Use 0 to disable.
+  - Ctrl-C cancels in-flight requests. Exit code 130 means interrupted, 143 terminated, 124 timed out.
+
 Examples:
   nuon api /v1/apps
   nuon api /v1/apps -q limit=5
+  nuon api /v1/apps --jq '.[].name' -r
+  nuon api /v1/installs --paginate
   nuon api /v1/apps '{"name":"my-app"}'
   nuon api /v1/apps/{app_id} --info
+  nuon api /v1/installs/{install_id}/drifted-objects --multi
+  nuon api /v1/apps -i
+  nuon api /v1/apps --header-out X-Nuon-Page-Next
   nuon api --list
 
 Agent-oriented examples:
@@ -92,19 +126,105 @@ Interactive endpoint browser:
 
 	root.Flags().StringP("method", "X", "", "HTTP method override (GET, POST, PUT, PATCH, DELETE)")
 	root.Flags().StringArrayP("query", "q", nil, "Query parameter as key=value (repeatable)")
+	root.Flags().StringArrayP("param", "p", nil, "Value for a path placeholder as name=value, e.g. -p workflow_id=... (repeatable)")
+	root.Flags().Bool("dry-run", false, "Resolve the request and print it, with the source of each path parameter, without sending it")
 	root.Flags().Bool("list", false, "Browse available API endpoints interactively (requires a TTY)")
 	root.Flags().Bool("show-deprecated", false, "Include deprecated endpoints in --list output")
 	root.Flags().Bool("info", false, "Show endpoint details (params, body schema) instead of executing")
 	root.Flags().Bool("raw", false, "Output raw JSON without formatting")
+	root.Flags().String("jq", "", "Filter JSON responses with a jq expression, e.g. '.[].name' (no jq install needed)")
+	root.Flags().BoolP("raw-output", "r", false, "With --jq, print string results without quotes")
+	root.Flags().BoolP("include", "i", false, "Print the response status line and headers before the body")
+	root.Flags().Bool("status", false, "Print only the response status code")
+	root.Flags().StringArray("header-out", nil, "Print only the value of this response header (repeatable)")
+	root.Flags().StringP("output", "o", "", "Write the response body to a file instead of stdout")
+	root.Flags().Bool("paginate", false, "Fetch all pages of a list endpoint and combine them into one JSON array")
+	root.Flags().Bool("stream", false, "With --paginate or --multi, print items as NDJSON as they arrive")
+	root.Flags().Int("max-items", 0, "With --paginate, stop after this many items (0 = unlimited)")
+	root.Flags().Int("max-pages", 0, "With --paginate, stop after this many pages (0 = unlimited)")
+	root.PersistentFlags().String("profile", "", "Saved context profile to use (env: NUON_API_PROFILE; default \"default\")")
+	root.PersistentFlags().Bool("insecure", false, "Skip TLS certificate verification (unsafe; env: NUON_API_INSECURE=true)")
+	root.Flags().Duration("cache-ttl", cfg.CacheTTL, "Cache GET responses on disk for this long, e.g. 5m (0 disables; env: NUON_API_CACHE_TTL)")
+	root.Flags().Bool("no-cache", false, "Bypass the response cache for this invocation")
+	root.Flags().Bool("strict-ids", false, "Fail instead of warning when an ID's prefix belongs to another path param (env: NUON_API_STRICT_IDS=true)")
+	root.Flags().Bool("last", false, "Reuse the most recent selection for each unresolved path param instead of prompting")
+	root.Flags().Bool("multi", false, "Pick several values for the last unresolved path param and run the request once per value")
+	root.Flags().Int("concurrency", dispatch.DefaultConcurrency, "With --multi, how many requests to run at once")
+	root.Flags().Bool("no-input", false, "Never prompt; fail on unresolved path params instead (env: NUON_NO_INPUT=true)")
+	root.Flags().Bool("trace", false, "Trace timings, headers and bodies of each request to stderr (secrets redacted)")
+	root.Flags().String("request-id", "", "X-Request-ID to send; later requests get a -2, -3, ... suffix (default: a generated ID per request)")
+	root.Flags().String("har", "", "Write every HTTP exchange of this invocation to a HAR 1.2 file (secrets redacted)")
+	root.Flags().String("record", "", "Save each HTTP request/response to this directory as cassette files (tokens scrubbed)")
+	root.Flags().String("replay", "", "Serve responses from cassette files in this directory instead of the network")
+	root.Flags().Duration("timeout", cfg.Timeout, "Per-request timeout, e.g. 30s or 2m (0 disables; env: NUON_API_TIMEOUT)")
 
 	root.AddCommand(tuiCmd())
+	root.AddCommand(mockCmd())
+	root.AddCommand(checkConnectionCmd())
+	root.AddCommand(contextCmd())
 
-	if err := root.Execute(); err != nil {
-		os.Exit(1)
+	ctx, stop := signalContext(context.Background())
+	defer stop()
+
+	err = root.ExecuteContext(ctx)
+	if err != nil {
+		code := exitCode(ctx, err)
+		stop()
+		os.Exit(code)
 	}
 }
 
+// warnInsecure makes disabled certificate verification impossible to miss.
+func warnInsecure() {
+	fmt.Fprintf(os.Stderr, "WARNING: TLS certificate verification is disabled (--insecure / NUON_API_INSECURE).\n")
+	fmt.Fprintf(os.Stderr, "WARNING: traffic to %s, including your API token, can be intercepted.\n", cfg.APIURL)
+}
+
+// signalCause is the cancellation cause of the root context when the process
+// receives a signal, so the exit code can tell SIGINT and SIGTERM apart.
+type signalCause struct {
+	Signal os.Signal
+}
+
+func (c signalCause) Error() string {
+	return "received " + c.Signal.String()
+}
+
+// signalContext returns a context cancelled with a signalCause on SIGINT or
+// SIGTERM. stop restores the default signal handling.
+func signalContext(parent context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(parent)
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case sig := <-ch:
+			cancel(signalCause{Signal: sig})
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, func() {
+		signal.Stop(ch)
+		cancel(nil)
+	}
+}
+
+// exitCode maps a command error to the process exit status.
+func exitCode(ctx context.Context, err error) int {
+	var sig signalCause
+	switch {
+	case errors.As(context.Cause(ctx), &sig) && sig.Signal == syscall.SIGTERM:
+		return exitTerminated
+	case ctx.Err() != nil, errors.Is(err, selector.ErrInterrupted):
+		return exitInterrupted
+	case errors.Is(err, context.DeadlineExceeded):
+		return exitTimeout
+	}
+	return exitError
+}
+
 func initAPI(cmd *cobra.Command, args []string) error {
+	loadContext(cmd)
 	if api != nil {
 		return nil
 	}
@@ -117,7 +237,7 @@ func initAPI(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runAPI(cmd *cobra.Command, args []string) error {
+func runAPI(cmd *cobra.Command, args []string) (err error) {
 	showList, _ := cmd.Flags().GetBool("list")
 	if showList {
 		showDeprecated, _ := cmd.Flags().GetBool("show-deprecated")
@@ -168,23 +288,84 @@ func runAPI(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("no endpoint found for path: %s", path)
 		}
 		output.PrintEndpointInfo(routes, cfg.APIURL)
+		if len(cfg.Context) > 0 {
+			fmt.Println()
+			printContext(cfg.Context)
+		}
 		return nil
 	}
 
-	raw, _ := cmd.Flags().GetBool("raw")
+	if trace, _ := cmd.Flags().GetBool("trace"); trace {
+		debug.EnableTrace()
+	}
+
+	outOpts, err := outputOptions(cmd)
+	if err != nil {
+		return err
+	}
 	methodOverride, _ := cmd.Flags().GetString("method")
+	cfg.Timeout, _ = cmd.Flags().GetDuration("timeout")
+	if insecure, _ := cmd.Flags().GetBool("insecure"); insecure {
+		cfg.Insecure = true
+	}
+	cfg.CacheTTL, _ = cmd.Flags().GetDuration("cache-ttl")
+	if noCache, _ := cmd.Flags().GetBool("no-cache"); noCache {
+		cfg.NoCache = true
+	}
+	if noInput, _ := cmd.Flags().GetBool("no-input"); noInput {
+		cfg.NoInput = true
+	}
+	cfg.Last, _ = cmd.Flags().GetBool("last")
+	if cfg.Multi, _ = cmd.Flags().GetBool("multi"); cfg.Multi {
+		if err := checkMultiFlags(cmd, outOpts); err != nil {
+			return err
+		}
+	}
+	if strictIDs, _ := cmd.Flags().GetBool("strict-ids"); strictIDs {
+		cfg.StrictIDs = true
+	}
+	cfg.RequestID, _ = cmd.Flags().GetString("request-id")
+	cfg.RecordDir, _ = cmd.Flags().GetString("record")
+	cfg.ReplayDir, _ = cmd.Flags().GetString("replay")
+	cfg.HARFile, _ = cmd.Flags().GetString("har")
+	paramFlags, _ := cmd.Flags().GetStringArray("param")
+	if cfg.Params, err = config.ParseParams(paramFlags); err != nil {
+		return err
+	}
+	if cfg.RecordDir != "" && cfg.ReplayDir != "" {
+		return fmt.Errorf("--record and --replay cannot be used together")
+	}
+	ctx := cmd.Context()
 
 	var payload string
 	if len(args) > 1 {
 		payload = args[1]
 	}
 
-	c := client.New(cfg)
+	if cfg.Insecure {
+		warnInsecure()
+	}
+	c, err := client.New(cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := c.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
 
-	req, err := dispatch.Resolve(api, path, payload, methodOverride, cfg, c)
+	reqs, err := dispatch.ResolveAll(ctx, api, path, payload, methodOverride, cfg, c)
 	if err != nil {
 		return err
 	}
+	prefixes := loadIDPrefixes()
+	defer saveIDPrefixes(prefixes)
+	for _, req := range reqs {
+		if err := checkIDs(prefixes, req); err != nil {
+			return err
+		}
+	}
 
 	// Parse -q key=value pairs into query params
 	queryFlags, _ := cmd.Flags().GetStringArray("query")
@@ -197,10 +378,146 @@ func runAPI(cmd *cobra.Command, args []string) error {
 		queryParams = append(queryParams, client.QueryParam{Key: k, Value: v})
 	}
 
-	resp, err := c.Do(req.Method, req.Path, req.Payload, queryParams...)
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		for i, req := range reqs {
+			if i > 0 {
+				fmt.Println()
+			}
+			printDryRun(c, req, queryParams)
+		}
+		return nil
+	}
+
+	if cfg.Multi {
+		return runMulti(cmd, c, reqs, queryParams, outOpts, prefixes)
+	}
+	req := reqs[0]
+
+	if paginate, _ := cmd.Flags().GetBool("paginate"); paginate {
+		return runPaginated(cmd, c, req, queryParams, outOpts, prefixes)
+	}
+
+	resp, err := c.Send(ctx, req.ClientRequest(queryParams))
 	if err != nil {
 		return err
 	}
+	if resp.StatusCode < 300 {
+		learnIDs(prefixes, req, resp.Body)
+	}
+
+	return output.Print(resp, outOpts)
+}
 
-	return output.Print(resp, raw)
+// printDryRun shows the request that would be sent and where each path
+// parameter value came from.
+func printDryRun(c *client.Client, req *dispatch.Request, queryParams []client.QueryParam) {
+	creq := req.ClientRequest(queryParams)
+	fmt.Printf("%s %s\n", creq.Method, c.URL(creq))
+	for _, p := range req.Params {
+		fmt.Printf("  {%s} = %s  (%s)\n", p.Name, p.Value, p.Source)
+	}
+	if req.Payload != "" {
+		fmt.Printf("\n%s\n", req.Payload)
+	}
+}
+
+func outputOptions(cmd *cobra.Command) (output.Options, error) {
+	var opts output.Options
+	opts.Raw, _ = cmd.Flags().GetBool("raw")
+	opts.Include, _ = cmd.Flags().GetBool("include")
+	opts.StatusOnly, _ = cmd.Flags().GetBool("status")
+	opts.Headers, _ = cmd.Flags().GetStringArray("header-out")
+	opts.OutputFile, _ = cmd.Flags().GetString("output")
+	opts.RawStrings, _ = cmd.Flags().GetBool("raw-output")
+
+	expr, _ := cmd.Flags().GetString("jq")
+	if expr == "" {
+		if opts.RawStrings {
+			return opts, fmt.Errorf("--raw-output (-r) requires --jq")
+		}
+		return opts, nil
+	}
+	if opts.StatusOnly || len(opts.Headers) > 0 {
+		return opts, fmt.Errorf("--jq cannot be combined with --status or --header-out")
+	}
+	var err error
+	opts.JQ, err = output.CompileJQ(expr)
+	return opts, err
+}
+
+// runPaginated walks every page of a list endpoint. -q offset/limit set the
+// starting offset and page size; the pager owns them from there. --include and
+// --header-out show the headers of the last page fetched.
+func runPaginated(cmd *cobra.Command, c *client.Client, req *dispatch.Request, queryParams []client.QueryParam, outOpts output.Options, prefixes *idprefix.Store) error {
+	if req.Method != "GET" {
+		return fmt.Errorf("--paginate only supports GET requests (got %s)", req.Method)
+	}
+	if outOpts.StatusOnly {
+		return fmt.Errorf("--paginate cannot be combined with --status")
+	}
+	stream, _ := cmd.Flags().GetBool("stream")
+	if stream && outOpts.OutputFile != "" {
+		return fmt.Errorf("--stream cannot be combined with --output")
+	}
+	if stream && (outOpts.Include || len(outOpts.Headers) > 0) {
+		return fmt.Errorf("--stream cannot be combined with --include or --header-out; the last page's headers are only known at the end")
+	}
+	if !hasQueryParam(req.Route, "offset") && !hasQueryParam(req.Route, "limit") {
+		return fmt.Errorf("--paginate: %s does not accept offset/limit parameters", req.Route.DisplayName())
+	}
+
+	opts := client.PageOptions{}
+	opts.MaxItems, _ = cmd.Flags().GetInt("max-items")
+	opts.MaxPages, _ = cmd.Flags().GetInt("max-pages")
+
+	var query []client.QueryParam
+	for _, qp := range queryParams {
+		var err error
+		switch qp.Key {
+		case "limit":
+			opts.PageSize, err = strconv.Atoi(qp.Value)
+		case "offset":
+			opts.Offset, err = strconv.Atoi(qp.Value)
+		case "page":
+			return fmt.Errorf("--paginate cannot be combined with -q page; use -q offset to set the starting point")
+		default:
+			query = append(query, qp)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid query parameter %s=%q: expected an integer", qp.Key, qp.Value)
+		}
+	}
+
+	var all []json.RawMessage
+	last, err := c.Paginate(cmd.Context(), req.Path, query, opts, func(items []json.RawMessage) error {
+		if body, err := json.Marshal(items); err == nil {
+			learnIDs(prefixes, req, body)
+		}
+		if stream {
+			return output.PrintNDJSON(items, outOpts)
+		}
+		all = append(all, items...)
+		return nil
+	})
+	var statusErr *client.StatusError
+	if errors.As(err, &statusErr) {
+		return output.Print(statusErr.Response, outOpts)
+	}
+	if err != nil {
+		return err
+	}
+
+	if stream {
+		return nil
+	}
+	return output.PrintPages(last, all, outOpts)
+}
+
+func hasQueryParam(route spec.Route, name string) bool {
+	for _, p := range route.QueryParams {
+		if p.Name == name {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestExitCodeForSignals(t *testing.T) {
+	tests := []struct {
+		sig  os.Signal
+		want int
+	}{
+		{os.Interrupt, exitInterrupted},
+		{syscall.SIGTERM, exitTerminated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sig.String(), func(t *testing.T) {
+			ctx, stop := signalContext(context.Background())
+			defer stop()
+
+			p, err := os.FindProcess(os.Getpid())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := p.Signal(tt.sig); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(5 * time.Second):
+				t.Fatal("context not cancelled by signal")
+			}
+
+			if got := exitCode(ctx, ctx.Err()); got != tt.want {
+				t.Fatalf("expected exit code %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExitCodeForTimeout(t *testing.T) {
+	if got := exitCode(context.Background(), context.DeadlineExceeded); got != exitTimeout {
+		t.Fatalf("expected exit code %d, got %d", exitTimeout, got)
+	}
+}
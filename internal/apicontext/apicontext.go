@@ -0,0 +1,93 @@
+package apicontext
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nuonco/nuon-ext-api/internal/atomicfile"
+)
+
+// DefaultProfile is used when no profile is selected.
+const DefaultProfile = "default"
+
+// File holds the default path parameter values of each profile, e.g.
+// "default" → {"app_id": "app...", "install_id": "ins..."}.
+type File struct {
+	path     string
+	Profiles map[string]map[string]string `json:"profiles"`
+}
+
+// Load reads the context file at path. A missing file is empty.
+func Load(path string) (*File, error) {
+	f := &File{path: path, Profiles: make(map[string]map[string]string)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	if f.Profiles == nil {
+		f.Profiles = make(map[string]map[string]string)
+	}
+	return f, nil
+}
+
+// Get returns the values of profile. The map must not be modified.
+func (f *File) Get(profile string) map[string]string {
+	return f.Profiles[profile]
+}
+
+// Set merges values into profile.
+func (f *File) Set(profile string, values map[string]string) {
+	if f.Profiles[profile] == nil {
+		f.Profiles[profile] = make(map[string]string)
+	}
+	for k, v := range values {
+		f.Profiles[profile][k] = v
+	}
+}
+
+// Clear removes params from profile, or the whole profile if none are given.
+func (f *File) Clear(profile string, params ...string) {
+	if len(params) == 0 {
+		delete(f.Profiles, profile)
+		return
+	}
+	for _, p := range params {
+		delete(f.Profiles[profile], p)
+	}
+	if len(f.Profiles[profile]) == 0 {
+		delete(f.Profiles, profile)
+	}
+}
+
+// Save writes the file atomically.
+func (f *File) Save() error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o700); err != nil {
+		return err
+	}
+	return atomicfile.Write(f.path, append(data, '\n'), 0o600)
+}
+
+// ParamName maps a context key to its path parameter: "app" and "app_id"
+// both name {app_id}.
+func ParamName(key string) string {
+	key = strings.Trim(strings.TrimSpace(key), "{}")
+	if strings.HasSuffix(key, "_id") {
+		return key
+	}
+	return key + "_id"
+}
@@ -0,0 +1,45 @@
+package apicontext
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSetClearAndPersistPerProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "context.json")
+	f, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.Set(DefaultProfile, map[string]string{"app_id": "app_1", "install_id": "ins_1"})
+	f.Set("staging", map[string]string{"app_id": "app_2"})
+	f.Clear(DefaultProfile, "install_id")
+	if err := f.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err = Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.Get(DefaultProfile); len(got) != 1 || got["app_id"] != "app_1" {
+		t.Fatalf("unexpected default context: %v", got)
+	}
+	if got := f.Get("staging"); got["app_id"] != "app_2" {
+		t.Fatalf("unexpected staging context: %v", got)
+	}
+
+	f.Clear("staging")
+	if f.Get("staging") != nil {
+		t.Fatal("expected clearing without params to drop the profile")
+	}
+}
+
+func TestParamName(t *testing.T) {
+	for key, want := range map[string]string{"app": "app_id", "install_id": "install_id", "{org_id}": "org_id"} {
+		if got := ParamName(key); got != want {
+			t.Fatalf("ParamName(%q): expected %q, got %q", key, want, got)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/nuonco/nuon-ext-api/internal/atomicfile"
+	"github.com/nuonco/nuon-ext-api/internal/debug"
+)
+
+// StatusHeader reports how a response was served: "hit", "revalidated" or "miss".
+const StatusHeader = "X-Nuon-Cache"
+
+// orgHeader scopes cache entries to the org a request was made for.
+const orgHeader = "X-Nuon-Org-ID"
+
+// Transport is an http.RoundTripper that caches successful GET responses on
+// disk. Entries are fresh for TTL; stale entries with an ETag are revalidated
+// with If-None-Match. Any successful non-GET request drops the org's entries.
+type Transport struct {
+	Dir  string
+	TTL  time.Duration
+	Next http.RoundTripper
+}
+
+// New wraps next (http.DefaultTransport if nil) with a cache stored in dir.
+func New(dir string, ttl time.Duration, next http.RoundTripper) *Transport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Transport{Dir: dir, TTL: ttl, Next: next}
+}
+
+// entry is the on-disk representation of a cached response.
+type entry struct {
+	StoredAt   time.Time   `json:"stored_at"`
+	StatusCode int         `json:"status_code"`
+	Status     string      `json:"status"`
+	Proto      string      `json:"proto"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		resp, err := t.Next.RoundTrip(req)
+		if err == nil && resp.StatusCode < 400 {
+			t.invalidate(req)
+		}
+		return resp, err
+	}
+
+	path := t.path(req)
+	cached, _ := t.load(path)
+
+	if cached != nil && time.Since(cached.StoredAt) < t.TTL {
+		debug.Log("cache: hit %s (age %s)", req.URL.RequestURI(), time.Since(cached.StoredAt).Round(time.Second))
+		return cached.response(req, "hit"), nil
+	}
+
+	etag := ""
+	if cached != nil {
+		etag = cached.Header.Get("ETag")
+	}
+	if etag != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := t.Next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body.Close()
+		cached.StoredAt = time.Now()
+		t.store(path, cached)
+		debug.Log("cache: revalidated %s", req.URL.RequestURI())
+		return cached.response(req, "revalidated"), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.store(path, &entry{
+		StoredAt:   time.Now(),
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Proto:      resp.Proto,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	})
+	resp.Header.Set(StatusHeader, "miss")
+	return resp, nil
+}
+
+func (e *entry) response(req *http.Request, status string) *http.Response {
+	header := e.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set(StatusHeader, status)
+	header.Set("Content-Length", strconv.Itoa(len(e.Body)))
+	return &http.Response{
+		StatusCode:    e.StatusCode,
+		Status:        e.Status,
+		Proto:         e.Proto,
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// orgDir holds all entries for the request's API host and org.
+func (t *Transport) orgDir(req *http.Request) string {
+	return filepath.Join(t.Dir, hash(req.URL.Host+"\n"+req.Header.Get(orgHeader)))
+}
+
+// path keys an entry by host, org, path, canonical query and Accept header.
+func (t *Transport) path(req *http.Request) string {
+	key := req.URL.Path + "?" + req.URL.Query().Encode() + "\n" + req.Header.Get("Accept")
+	return filepath.Join(t.orgDir(req), hash(key)+".json")
+}
+
+func (t *Transport) load(path string) (*entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// store writes e atomically. Failures only cost a future cache miss.
+func (t *Transport) store(path string, e *entry) {
+	if err := writeEntry(path, e); err != nil {
+		debug.Log("cache: not stored: %v", err)
+	}
+}
+
+func writeEntry(path string, e *entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return atomicfile.Write(path, data, 0o600)
+}
+
+func (t *Transport) invalidate(req *http.Request) {
+	dir := t.orgDir(req)
+	if err := os.RemoveAll(dir); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		debug.Log("cache: invalidation failed: %v", err)
+		return
+	}
+	debug.Log("cache: invalidated after %s %s", req.Method, req.URL.Path)
+}
+
+func hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func get(t *testing.T, c *http.Client, url string) (*http.Response, string) {
+	t.Helper()
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set(orgHeader, "org_1")
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	return resp, string(body)
+}
+
+func TestFreshEntryIsServedFromDisk(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`[{"id":"app_1"}]`))
+	}))
+	defer srv.Close()
+
+	c := &http.Client{Transport: New(t.TempDir(), time.Minute, nil)}
+
+	get(t, c, srv.URL+"/v1/apps?limit=5&offset=0")
+	resp, body := get(t, c, srv.URL+"/v1/apps?offset=0&limit=5")
+
+	if calls != 1 {
+		t.Fatalf("expected 1 request to the server, got %d", calls)
+	}
+	if resp.Header.Get(StatusHeader) != "hit" || body != `[{"id":"app_1"}]` {
+		t.Fatalf("expected cached body, got %s %q", resp.Header.Get(StatusHeader), body)
+	}
+}
+
+func TestStaleEntryIsRevalidatedWithETag(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	c := &http.Client{Transport: New(t.TempDir(), time.Nanosecond, nil)}
+
+	get(t, c, srv.URL+"/v1/installs")
+	time.Sleep(time.Millisecond)
+	resp, body := get(t, c, srv.URL+"/v1/installs")
+
+	if calls != 2 {
+		t.Fatalf("expected a revalidation request, got %d requests", calls)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Header.Get(StatusHeader) != "revalidated" || body != `[]` {
+		t.Fatalf("expected revalidated cached body, got %d %s %q", resp.StatusCode, resp.Header.Get(StatusHeader), body)
+	}
+}
+
+func TestWriteInvalidatesOrgEntriesAndErrorsAreNotCached(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path == "/v1/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	c := &http.Client{Transport: New(t.TempDir(), time.Minute, nil)}
+
+	get(t, c, srv.URL+"/v1/missing")
+	get(t, c, srv.URL+"/v1/missing")
+	if calls != 2 {
+		t.Fatalf("expected error responses not to be cached, got %d requests", calls)
+	}
+
+	get(t, c, srv.URL+"/v1/apps")
+	req, _ := http.NewRequest("POST", srv.URL+"/v1/apps", nil)
+	req.Header.Set(orgHeader, "org_1")
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	calls = 0
+	get(t, c, srv.URL+"/v1/apps")
+	if calls != 1 {
+		t.Fatalf("expected the write to invalidate cached GETs, got %d requests", calls)
+	}
+}
@@ -0,0 +1,262 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/nuonco/nuon-ext-api/internal/httpbody"
+	"github.com/nuonco/nuon-ext-api/internal/redact"
+)
+
+// Interaction is one recorded request/response pair. Each interaction is
+// stored as its own JSON file so recordings can be inspected, edited and
+// attached to bug reports.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the recorded side of an outgoing request.
+type Request struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"` // canonical (sorted) query string
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body,omitzero"`
+}
+
+// Response is the recorded side of a server response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Status     string      `json:"status"`
+	Proto      string      `json:"proto"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitzero"`
+}
+
+// Body holds a payload as text when it is valid UTF-8 and as base64 otherwise.
+type Body struct {
+	Encoding string `json:"encoding,omitempty"` // "" for text, "base64" for binary
+	Data     string `json:"data"`
+}
+
+func newBody(data []byte) Body {
+	if utf8.Valid(data) {
+		return Body{Data: string(data)}
+	}
+	return Body{Encoding: "base64", Data: base64.StdEncoding.EncodeToString(data)}
+}
+
+// Bytes returns the decoded payload.
+func (b Body) Bytes() ([]byte, error) {
+	if b.Encoding == "base64" {
+		return base64.StdEncoding.DecodeString(b.Data)
+	}
+	return []byte(b.Data), nil
+}
+
+// canonicalQuery sorts query parameters so matching ignores their order, and
+// scrubs secret ones. Replay scrubs the same way, so requests still match.
+func canonicalQuery(u *url.URL) string {
+	return redact.Query(u.Query()).Encode()
+}
+
+// Recorder is an http.RoundTripper that saves every exchange to Dir.
+type Recorder struct {
+	Dir  string
+	Next http.RoundTripper
+
+	mu  sync.Mutex
+	seq int
+}
+
+// NewRecorder wraps next (http.DefaultTransport if nil) and writes
+// interactions to dir, continuing the numbering of any existing recordings.
+func NewRecorder(dir string, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	existing, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	return &Recorder{Dir: dir, Next: next, seq: len(existing)}
+}
+
+// RoundTrip performs the request and records it. Credentials are scrubbed
+// from headers and secret query parameters; bodies are stored verbatim so
+// replay serves exactly what the server returned.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := httpbody.Drain(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("record: reading request body: %w", err)
+	}
+
+	resp, err := r.Next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := httpbody.Drain(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("record: reading response body: %w", err)
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  canonicalQuery(req.URL),
+			Header: redact.Header(req.Header),
+			Body:   newBody(reqBody),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Proto:      resp.Proto,
+			Header:     redact.Header(resp.Header),
+			Body:       newBody(respBody),
+		},
+	}
+	if err := r.save(interaction); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+func (r *Recorder) save(in Interaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := os.MkdirAll(r.Dir, 0o755); err != nil {
+		return fmt.Errorf("record: %w", err)
+	}
+
+	r.seq++
+	slug := strings.Trim(unsafeChars.ReplaceAllString(in.Request.Path, "-"), "-")
+	name := fmt.Sprintf("%04d-%s-%s.json", r.seq, in.Request.Method, slug)
+
+	data, err := json.MarshalIndent(in, "", "  ")
+	if err != nil {
+		return fmt.Errorf("record: encoding interaction: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(r.Dir, name), append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("record: %w", err)
+	}
+	return nil
+}
+
+// Replayer is an http.RoundTripper that answers from recorded interactions
+// and never touches the network.
+type Replayer struct {
+	Dir string
+
+	once    sync.Once
+	loadErr error
+
+	mu      sync.Mutex
+	entries []*entry
+}
+
+type entry struct {
+	Interaction
+	used bool
+}
+
+// NewReplayer serves interactions recorded in dir. Files are loaded on first use.
+func NewReplayer(dir string) *Replayer {
+	return &Replayer{Dir: dir}
+}
+
+// RoundTrip returns the recorded response matching the request's method, path
+// and query. Identical requests are served in recording order; once all
+// matches are used the last one is repeated.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.once.Do(r.load)
+	if r.loadErr != nil {
+		return nil, r.loadErr
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	query := canonicalQuery(req.URL)
+
+	r.mu.Lock()
+	var match *entry
+	for _, e := range r.entries {
+		if e.Request.Method != req.Method || e.Request.Path != req.URL.Path || e.Request.Query != query {
+			continue
+		}
+		match = e
+		if !e.used {
+			break
+		}
+	}
+	if match != nil {
+		match.used = true
+	}
+	r.mu.Unlock()
+
+	if match == nil {
+		target := req.URL.Path
+		if query != "" {
+			target += "?" + query
+		}
+		return nil, fmt.Errorf("replay: no recorded interaction for %s %s in %s", req.Method, target, r.Dir)
+	}
+
+	body, err := match.Response.Body.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("replay: decoding body: %w", err)
+	}
+
+	return &http.Response{
+		StatusCode:    match.Response.StatusCode,
+		Status:        match.Response.Status,
+		Proto:         match.Response.Proto,
+		Header:        match.Response.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Replayer) load() {
+	files, err := filepath.Glob(filepath.Join(r.Dir, "*.json"))
+	if err != nil {
+		r.loadErr = fmt.Errorf("replay: %w", err)
+		return
+	}
+	if len(files) == 0 {
+		r.loadErr = fmt.Errorf("replay: no recordings found in %s", r.Dir)
+		return
+	}
+	sort.Strings(files)
+
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			r.loadErr = fmt.Errorf("replay: %w", err)
+			return
+		}
+		var in Interaction
+		if err := json.Unmarshal(data, &in); err != nil {
+			r.loadErr = fmt.Errorf("replay: parsing %s: %w", f, err)
+			return
+		}
+		r.entries = append(r.entries, &entry{Interaction: in})
+	}
+}
@@ -0,0 +1,112 @@
+package cassette
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordThenReplay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":"app_1","limit":"` + r.URL.Query().Get("limit") + `"}]`))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	recorder := &http.Client{Transport: NewRecorder(dir, nil)}
+
+	req, _ := http.NewRequest("GET", srv.URL+"/v1/apps?offset=0&limit=5", nil)
+	req.Header.Set("Authorization", "Bearer secret-token")
+	resp, err := recorder.Do(req)
+	if err != nil {
+		t.Fatalf("recording request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("expected 1 cassette file, got %d", len(files))
+	}
+	data, _ := os.ReadFile(files[0])
+	if strings.Contains(string(data), "secret-token") {
+		t.Fatal("expected the token to be scrubbed from the cassette")
+	}
+
+	srv.Close()
+
+	// Query parameter order must not matter when replaying.
+	replayer := &http.Client{Transport: NewReplayer(dir)}
+	resp, err = replayer.Get("http://replay.invalid/v1/apps?limit=5&offset=0")
+	if err != nil {
+		t.Fatalf("replaying request failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	if string(body) != `[{"id":"app_1","limit":"5"}]` {
+		t.Fatalf("unexpected replayed body %q", body)
+	}
+}
+
+func TestReplayFailsForUnknownRequest(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "0001-GET-v1-apps.json"), []byte(`{
+  "request": {"method": "GET", "path": "/v1/apps"},
+  "response": {"status_code": 200, "body": {"data": "[]"}}
+}`), 0o600)
+
+	replayer := &http.Client{Transport: NewReplayer(dir)}
+	_, err := replayer.Get("http://replay.invalid/v1/installs")
+	if err == nil || !strings.Contains(err.Error(), "no recorded interaction for GET /v1/installs") {
+		t.Fatalf("expected missing interaction error, got %v", err)
+	}
+}
+
+func TestRecorderScrubsQueryAndKeepsBodies(t *testing.T) {
+	const respBody = `{"id":"vcs_1","access_token":"resp-value"}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(respBody))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	recorder := &http.Client{Transport: NewRecorder(dir, nil)}
+	resp, err := recorder.Post(srv.URL+"/v1/vcs?api_key=query-secret", "application/json",
+		strings.NewReader(`{"name":"gh","password":"req-value"}`))
+	if err != nil {
+		t.Fatalf("recording request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("expected 1 cassette file, got %d", len(files))
+	}
+	data, _ := os.ReadFile(files[0])
+	if strings.Contains(string(data), "query-secret") {
+		t.Fatalf("expected the secret query param to be scrubbed from the cassette:\n%s", data)
+	}
+	if !strings.Contains(string(data), "req-value") {
+		t.Fatalf("expected the request body to be stored verbatim:\n%s", data)
+	}
+
+	replayer := &http.Client{Transport: NewReplayer(dir)}
+	resp, err = replayer.Post("http://replay.invalid/v1/vcs?api_key=other-secret", "application/json", nil)
+	if err != nil {
+		t.Fatalf("expected the scrubbed query to still match, got %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != respBody {
+		t.Fatalf("expected the response replayed as recorded, got %q", body)
+	}
+}
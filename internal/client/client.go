@@ -1,14 +1,21 @@
 package client
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	neturl "net/url"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/nuonco/nuon-ext-api/internal/config"
 	"github.com/nuonco/nuon-ext-api/internal/debug"
+	"github.com/nuonco/nuon-ext-api/internal/har"
 )
 
 // QueryParam represents a key=value query parameter.
@@ -23,47 +30,135 @@ type Client struct {
 	baseURL string
 	token   string
 	orgID   string
+	timeout time.Duration
+
+	requestID string       // fixed X-Request-ID; generated per request when empty
+	sent      atomic.Int64 // requests sent, to number the fixed ID
+
+	har *har.Recorder // set when a HAR file is requested; written by Close
+}
+
+// New creates a Client from the loaded config. It fails when the transport
+// settings (CA bundle, client certificate, proxy) cannot be applied.
+func New(cfg *config.Config) (*Client, error) {
+	rt, err := transport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewWithTransport(cfg, rt)
+	if rec, ok := rt.(*har.Recorder); ok {
+		c.har = rec
+	}
+	return c, nil
 }
 
-// New creates a Client from the loaded config.
-func New(cfg *config.Config) *Client {
+// NewWithTransport creates a Client that sends requests through rt instead of
+// the transport built from cfg's TLS, proxy, cache and recording settings.
+func NewWithTransport(cfg *config.Config, rt http.RoundTripper) *Client {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
 	return &Client{
-		http:    &http.Client{},
-		baseURL: strings.TrimRight(cfg.APIURL, "/"),
+		http:    &http.Client{Transport: rt},
+		baseURL: baseURL(cfg.APIURL),
 		token:   cfg.APIToken,
-		orgID:   cfg.OrgID,
+		orgID:   cfg.Org(),
+		timeout: cfg.Timeout,
+
+		requestID: cfg.RequestID,
 	}
 }
 
+// Close flushes session artifacts such as the HAR file. It is safe to call
+// on a client without any.
+func (c *Client) Close() error {
+	if c.har == nil {
+		return nil
+	}
+	return c.har.Close()
+}
+
 // Response holds the raw result of an API call.
 type Response struct {
 	StatusCode int
+	Status     string // e.g. "200 OK"
+	Proto      string // e.g. "HTTP/1.1"
 	Body       []byte
 	Header     http.Header
+
+	RequestID       string // X-Request-ID sent with the request
+	ServerRequestID string // request/trace ID assigned by the server, if any
+}
+
+// Request describes a single API call.
+type Request struct {
+	Method  string
+	Path    string
+	Payload string // raw JSON body
+	Query   []QueryParam
+	Accept  string // Accept header; defaults to application/json
 }
 
 // Do executes an HTTP request against the API.
-func (c *Client) Do(method, path, payload string, queryParams ...QueryParam) (*Response, error) {
-	reqURL := c.baseURL + path
+// The request is aborted when ctx is cancelled or the client timeout elapses.
+func (c *Client) Do(ctx context.Context, method, path, payload string, queryParams ...QueryParam) (*Response, error) {
+	return c.Send(ctx, &Request{Method: method, Path: path, Payload: payload, Query: queryParams})
+}
 
-	if len(queryParams) > 0 {
-		q := make(neturl.Values)
-		for _, qp := range queryParams {
-			q.Add(qp.Key, qp.Value)
-		}
-		if strings.Contains(reqURL, "?") {
-			reqURL += "&" + q.Encode()
-		} else {
-			reqURL += "?" + q.Encode()
-		}
+// URL returns the full URL r is sent to, including its query parameters.
+func (c *Client) URL(r *Request) string {
+	reqURL := c.baseURL + r.Path
+	if len(r.Query) == 0 {
+		return reqURL
+	}
+
+	q := make(neturl.Values)
+	for _, qp := range r.Query {
+		q.Add(qp.Key, qp.Value)
+	}
+	if strings.Contains(reqURL, "?") {
+		return reqURL + "&" + q.Encode()
 	}
+	return reqURL + "?" + q.Encode()
+}
+
+// nextRequestID returns the X-Request-ID for the next request: a generated
+// one, or the fixed ID. The fixed ID is sent as-is on the first request and
+// with a -2, -3, ... suffix on later ones, so each request stays traceable.
+func (c *Client) nextRequestID() string {
+	if c.requestID == "" {
+		return NewRequestID()
+	}
+	if n := c.sent.Add(1); n > 1 {
+		return fmt.Sprintf("%s-%d", c.requestID, n)
+	}
+	return c.requestID
+}
+
+// Send executes r against the API. Gzip-encoded response bodies are decoded.
+func (c *Client) Send(ctx context.Context, r *Request) (*Response, error) {
+	method, payload := r.Method, r.Payload
+	reqURL := c.URL(r)
 
 	var body io.Reader
 	if payload != "" {
 		body = strings.NewReader(payload)
 	}
 
-	req, err := http.NewRequest(method, reqURL, body)
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	var tr *tracer
+	if debug.Tracing() {
+		tr = newTracer()
+		ctx = tr.WithContext(ctx)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
 	if err != nil {
 		return nil, fmt.Errorf("building request: %w", err)
 	}
@@ -80,26 +175,97 @@ func (c *Client) Do(method, path, payload string, queryParams ...QueryParam) (*R
 	if payload != "" {
 		req.Header.Set("Content-Type", "application/json")
 	}
-	req.Header.Set("Accept", "application/json")
+	accept := r.Accept
+	if accept == "" {
+		accept = "application/json"
+	}
+	req.Header.Set("Accept", accept)
 
-	debug.Log("http: %s %s", method, reqURL)
+	requestID := c.nextRequestID()
+	req.Header.Set(RequestIDHeader, requestID)
+
+	debug.Log("http: %s %s (request_id=%s)", method, reqURL, requestID)
+	if tr != nil {
+		tr.request(req, payload)
+	}
 
 	resp, err := c.http.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("executing request: %w", err)
+		return nil, fmt.Errorf("%w (request id %s)", c.wrapErr("executing request", err), requestID)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("reading response: %w", err)
+		return nil, fmt.Errorf("%w (request id %s)", c.wrapErr("reading response", err), requestID)
+	}
+
+	respBody, err = decodeBody(resp.Header, respBody)
+	if err != nil {
+		return nil, fmt.Errorf("%w (request id %s)", err, requestID)
 	}
 
-	debug.Log("http: %d (%d bytes)", resp.StatusCode, len(respBody))
+	if tr != nil {
+		tr.response(resp, respBody)
+	}
+
+	serverID := serverRequestID(resp.Header, requestID)
+	if serverID != "" {
+		debug.Log("http: %d %s (%d bytes, request_id=%s server_request_id=%s)", resp.StatusCode, resp.Header.Get("Content-Type"), len(respBody), requestID, serverID)
+	} else {
+		debug.Log("http: %d %s (%d bytes, request_id=%s)", resp.StatusCode, resp.Header.Get("Content-Type"), len(respBody), requestID)
+	}
 
 	return &Response{
 		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Proto:      resp.Proto,
 		Body:       respBody,
 		Header:     resp.Header,
+
+		RequestID:       requestID,
+		ServerRequestID: serverID,
 	}, nil
 }
+
+// decodeBody gunzips bodies that still carry Content-Encoding: gzip. The
+// transport only decodes transparently when it negotiated gzip itself, so
+// endpoints that always gzip (e.g. approval contents) arrive encoded.
+func decodeBody(header http.Header, body []byte) ([]byte, error) {
+	if !strings.EqualFold(header.Get("Content-Encoding"), "gzip") {
+		return body, nil
+	}
+	if len(body) < 2 || body[0] != 0x1f || body[1] != 0x8b {
+		return body, nil
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("decoding gzip response: %w", err)
+	}
+	defer zr.Close()
+
+	decoded, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("decoding gzip response: %w", err)
+	}
+
+	header.Del("Content-Encoding")
+	header.Del("Content-Length")
+	return decoded, nil
+}
+
+// wrapErr annotates transport errors so timeouts and cancellations read clearly
+// while staying matchable with errors.Is(err, context.DeadlineExceeded/Canceled).
+func (c *Client) wrapErr(action string, err error) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		if c.timeout > 0 {
+			return fmt.Errorf("%s: timed out after %s: %w", action, c.timeout, context.DeadlineExceeded)
+		}
+		return fmt.Errorf("%s: %w", action, context.DeadlineExceeded)
+	case errors.Is(err, context.Canceled):
+		return fmt.Errorf("%s: %w", action, context.Canceled)
+	}
+	return fmt.Errorf("%s: %w", action, err)
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nuonco/nuon-ext-api/internal/config"
+)
+
+func newTestClient(t *testing.T, cfg *config.Config) *Client {
+	t.Helper()
+	c, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	return c
+}
+
+func TestDoTimesOutHungRequest(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	c := newTestClient(t, &config.Config{APIURL: srv.URL, Timeout: 50 * time.Millisecond})
+
+	_, err := c.Do(context.Background(), "GET", "/v1/apps", "")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestDoStopsOnCancelledContext(t *testing.T) {
+	started := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, &config.Config{APIURL: srv.URL})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	_, err := c.Do(ctx, "GET", "/v1/apps", "")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+}
+
+func TestSendSendsRouteAccept(t *testing.T) {
+	var gotAccept string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAccept = r.Header.Get("Accept")
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, &config.Config{APIURL: srv.URL})
+	if _, err := c.Send(context.Background(), &Request{Method: "GET", Path: "/v1/audit", Accept: "text/csv"}); err != nil {
+		t.Fatalf("Send() returned error: %v", err)
+	}
+
+	if gotAccept != "text/csv" {
+		t.Fatalf("expected Accept %q, got %q", "text/csv", gotAccept)
+	}
+}
+
+func TestDecodeBodyGunzipsEncodedBody(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(`{"contents":"plan"}`))
+	zw.Close()
+
+	header := http.Header{"Content-Encoding": {"gzip"}}
+	body, err := decodeBody(header, buf.Bytes())
+	if err != nil {
+		t.Fatalf("decodeBody() returned error: %v", err)
+	}
+
+	if string(body) != `{"contents":"plan"}` {
+		t.Fatalf("expected decoded body, got %q", body)
+	}
+	if header.Get("Content-Encoding") != "" {
+		t.Fatal("expected Content-Encoding to be removed after decoding")
+	}
+}
+
+func TestDoErrorsCarryRequestID(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gzip":
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Write([]byte{0x1f, 0x8b, 0x00})
+		case "/short":
+			w.Header().Set("Content-Length", "100")
+			w.Write([]byte("{"))
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, &config.Config{APIURL: srv.URL, RequestID: "req_fixed"})
+	for _, path := range []string{"/short", "/gzip"} {
+		_, err := c.Do(context.Background(), "GET", path, "")
+		if err == nil || !strings.Contains(err.Error(), "(request id req_fixed") {
+			t.Fatalf("%s: expected an error naming the request ID, got %v", path, err)
+		}
+	}
+}
+
+func TestSendSetsRequestIDAndCapturesServerID(t *testing.T) {
+	var gotID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotID = r.Header.Get(RequestIDHeader)
+		w.Header().Set(RequestIDHeader, gotID)
+		w.Header().Set("X-Trace-ID", "trace-123")
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, &config.Config{APIURL: srv.URL})
+	resp, err := c.Do(context.Background(), "GET", "/v1/apps", "")
+	if err != nil {
+		t.Fatalf("Do() returned error: %v", err)
+	}
+
+	if len(gotID) != 26 || gotID != resp.RequestID {
+		t.Fatalf("expected a 26 character request ID echoed in the response, sent %q recorded %q", gotID, resp.RequestID)
+	}
+	if resp.ServerRequestID != "trace-123" {
+		t.Fatalf("expected server request ID %q, got %q", "trace-123", resp.ServerRequestID)
+	}
+
+	fixed := newTestClient(t, &config.Config{APIURL: srv.URL, RequestID: "req_from_flag"})
+	if _, err := fixed.Do(context.Background(), "GET", "/v1/apps", ""); err != nil {
+		t.Fatal(err)
+	}
+	if gotID != "req_from_flag" {
+		t.Fatalf("expected the configured request ID to be sent, got %q", gotID)
+	}
+	for _, want := range []string{"req_from_flag-2", "req_from_flag-3"} {
+		if _, err := fixed.Do(context.Background(), "GET", "/v1/apps", ""); err != nil {
+			t.Fatal(err)
+		}
+		if gotID != want {
+			t.Fatalf("expected later requests to be numbered %q, got %q", want, gotID)
+		}
+	}
+}
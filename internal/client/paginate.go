@@ -0,0 +1,149 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/nuonco/nuon-ext-api/internal/debug"
+)
+
+// DefaultPageSize is the limit requested per page when the caller doesn't set one.
+const DefaultPageSize = 100
+
+// pageNextHeader, when a response has it, says whether another page exists
+// ("true"/"false"). The API spec does not document it, so paging never
+// depends on it; see hasNextPage.
+const pageNextHeader = "X-Nuon-Page-Next"
+
+// PageOptions controls how Paginate walks a list endpoint.
+type PageOptions struct {
+	PageSize int // items requested per page (limit); DefaultPageSize if zero
+	Offset   int // offset of the first page
+	MaxItems int // stop after this many items (0 = unlimited)
+	MaxPages int // stop after this many pages (0 = unlimited)
+}
+
+// StatusError is returned when the API answers with an HTTP error status.
+// It carries the response so callers can render the error body.
+type StatusError struct {
+	Response *Response
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("HTTP %d", e.Response.StatusCode)
+}
+
+// Paginate GETs path page by page using offset/limit query params and calls fn
+// with the items of each page, until the data is exhausted or a limit in opts
+// is reached. It returns the response of the last page fetched, for its status
+// and headers.
+//
+// The end of data is a page shorter than the requested limit, unless the
+// response carries the X-Nuon-Page-Next header, which then decides. Paging
+// also stops when a page repeats the previous one (the endpoint ignores
+// offset).
+func (c *Client) Paginate(ctx context.Context, path string, query []QueryParam, opts PageOptions, fn func(items []json.RawMessage) error) (*Response, error) {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	offset := opts.Offset
+	total := 0
+	var prevFirst json.RawMessage
+	var last *Response
+
+	for page := 1; ; page++ {
+		items, resp, err := c.getPage(ctx, path, query, offset, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		last = resp
+		next := resp.Header.Get(pageNextHeader)
+
+		if len(items) > 0 && prevFirst != nil && bytes.Equal(items[0], prevFirst) {
+			debug.Log("paginate: page %d repeats the previous page, endpoint ignores offset", page)
+			return last, nil
+		}
+
+		if opts.MaxItems > 0 && total+len(items) > opts.MaxItems {
+			items = items[:opts.MaxItems-total]
+		}
+		if len(items) > 0 {
+			if err := fn(items); err != nil {
+				return nil, err
+			}
+		}
+		total += len(items)
+
+		debug.Log("paginate: page %d offset=%d items=%d total=%d", page, offset, len(items), total)
+
+		if opts.MaxItems > 0 && total >= opts.MaxItems {
+			return last, nil
+		}
+		if opts.MaxPages > 0 && page >= opts.MaxPages {
+			return last, nil
+		}
+
+		if !hasNextPage(next, len(items), pageSize) {
+			return last, nil
+		}
+		prevFirst = items[0]
+		offset += len(items)
+	}
+}
+
+// Page GETs up to limit items of path starting at offset. more reports whether
+// another page follows: the X-Nuon-Page-Next header when the response has it,
+// otherwise whether the page was full.
+func (c *Client) Page(ctx context.Context, path string, query []QueryParam, offset, limit int) ([]json.RawMessage, bool, error) {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	items, resp, err := c.getPage(ctx, path, query, offset, limit)
+	if err != nil {
+		return nil, false, err
+	}
+	next := resp.Header.Get(pageNextHeader)
+	debug.Log("paginate: offset=%d items=%d next=%q", offset, len(items), next)
+
+	return items, hasNextPage(next, len(items), limit), nil
+}
+
+// hasNextPage reports whether a page of n items fetched with limit is followed
+// by another. The page-next header decides when present; otherwise a short
+// page is the last one.
+func hasNextPage(next string, n, limit int) bool {
+	switch next {
+	case "true":
+		return n > 0
+	case "false":
+		return false
+	}
+	return n >= limit
+}
+
+// getPage GETs one page of path and decodes it as a JSON array.
+func (c *Client) getPage(ctx context.Context, path string, query []QueryParam, offset, limit int) ([]json.RawMessage, *Response, error) {
+	pageQuery := append(append([]QueryParam{}, query...),
+		QueryParam{Key: "offset", Value: strconv.Itoa(offset)},
+		QueryParam{Key: "limit", Value: strconv.Itoa(limit)},
+	)
+
+	resp, err := c.Do(ctx, "GET", path, "", pageQuery...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return nil, nil, &StatusError{Response: resp}
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(resp.Body, &items); err != nil {
+		return nil, nil, fmt.Errorf("paginating %s: response is not a JSON array", path)
+	}
+	return items, resp, nil
+}
@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/nuonco/nuon-ext-api/internal/config"
+)
+
+// listServer serves total items as {"id":"item_N"} honouring offset/limit.
+func listServer(t *testing.T, total int, sendNextHeader bool) (*httptest.Server, *int) {
+	t.Helper()
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		items := []map[string]string{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, map[string]string{"id": fmt.Sprintf("item_%d", i)})
+		}
+		if sendNextHeader {
+			w.Header().Set(pageNextHeader, strconv.FormatBool(offset+len(items) < total))
+		}
+		json.NewEncoder(w).Encode(items)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func collect(t *testing.T, c *Client, opts PageOptions) []json.RawMessage {
+	t.Helper()
+	var all []json.RawMessage
+	_, err := c.Paginate(context.Background(), "/v1/installs", nil, opts, func(items []json.RawMessage) error {
+		all = append(all, items...)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Paginate() returned error: %v", err)
+	}
+	return all
+}
+
+func TestPaginateFollowsNextHeader(t *testing.T) {
+	srv, calls := listServer(t, 25, true)
+	c := newTestClient(t, &config.Config{APIURL: srv.URL})
+
+	items := collect(t, c, PageOptions{PageSize: 10})
+	if len(items) != 25 {
+		t.Fatalf("expected 25 items, got %d", len(items))
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 requests, got %d", *calls)
+	}
+}
+
+func TestPaginateWithoutNextHeader(t *testing.T) {
+	tests := []struct {
+		total, calls int
+	}{
+		{25, 3}, // the short third page ends the list
+		{20, 3}, // full pages until an empty one
+		{0, 1},
+	}
+	for _, tt := range tests {
+		srv, calls := listServer(t, tt.total, false)
+		c := newTestClient(t, &config.Config{APIURL: srv.URL})
+
+		items := collect(t, c, PageOptions{PageSize: 10})
+		if len(items) != tt.total {
+			t.Fatalf("total=%d: expected %d items, got %d", tt.total, tt.total, len(items))
+		}
+		if *calls != tt.calls {
+			t.Fatalf("total=%d: expected %d requests, got %d", tt.total, tt.calls, *calls)
+		}
+	}
+}
+
+func TestPaginateReturnsLastPage(t *testing.T) {
+	srv, _ := listServer(t, 25, true)
+	c := newTestClient(t, &config.Config{APIURL: srv.URL})
+
+	last, err := c.Paginate(context.Background(), "/v1/installs", nil, PageOptions{PageSize: 10}, func([]json.RawMessage) error { return nil })
+	if err != nil {
+		t.Fatalf("Paginate() returned error: %v", err)
+	}
+	var items []json.RawMessage
+	json.Unmarshal(last.Body, &items)
+	if last.Header.Get(pageNextHeader) != "false" || len(items) != 5 {
+		t.Fatalf("expected the last page's response, got %s=%q with %d items", pageNextHeader, last.Header.Get(pageNextHeader), len(items))
+	}
+}
+
+func TestPaginateStopsWhenOffsetIgnored(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`[{"id":"a"},{"id":"b"}]`))
+	}))
+	defer srv.Close()
+	c := newTestClient(t, &config.Config{APIURL: srv.URL})
+
+	items := collect(t, c, PageOptions{PageSize: 2})
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	if calls != 2 {
+		t.Fatalf("expected paging to stop after the repeated page, got %d requests", calls)
+	}
+}
+
+func TestPaginateRespectsMaxItems(t *testing.T) {
+	srv, _ := listServer(t, 50, true)
+	c := newTestClient(t, &config.Config{APIURL: srv.URL})
+
+	items := collect(t, c, PageOptions{PageSize: 10, MaxItems: 15})
+	if len(items) != 15 {
+		t.Fatalf("expected 15 items, got %d", len(items))
+	}
+}
+
+func TestPaginateReturnsStatusError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error":"forbidden"}`))
+	}))
+	defer srv.Close()
+	c := newTestClient(t, &config.Config{APIURL: srv.URL})
+
+	_, err := c.Paginate(context.Background(), "/v1/installs", nil, PageOptions{}, func([]json.RawMessage) error { return nil })
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("expected StatusError, got %v", err)
+	}
+	if statusErr.Response.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected status 403, got %d", statusErr.Response.StatusCode)
+	}
+}
+
+func TestPageReportsMore(t *testing.T) {
+	for _, header := range []bool{true, false} {
+		srv, _ := listServer(t, 15, header)
+		c := newTestClient(t, &config.Config{APIURL: srv.URL})
+
+		items, more, err := c.Page(context.Background(), "/v1/installs", nil, 0, 10)
+		if err != nil || len(items) != 10 || !more {
+			t.Fatalf("header=%t: expected a full first page with more, got %d items, more=%t, err=%v", header, len(items), more, err)
+		}
+		items, more, err = c.Page(context.Background(), "/v1/installs", nil, 10, 10)
+		if err != nil || len(items) != 5 || more {
+			t.Fatalf("header=%t: expected a short last page, got %d items, more=%t, err=%v", header, len(items), more, err)
+		}
+	}
+}
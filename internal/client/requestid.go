@@ -0,0 +1,43 @@
+package client
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"net/http"
+	"strings"
+)
+
+// RequestIDHeader carries the client-generated correlation ID on every request.
+const RequestIDHeader = "X-Request-ID"
+
+// serverIDHeaders are checked in order for the request/trace ID the API or
+// an intermediate proxy assigned to the request.
+var serverIDHeaders = []string{
+	"X-Nuon-Request-ID",
+	"X-Request-ID",
+	"X-Trace-ID",
+	"X-Amzn-Trace-Id",
+	"X-Cloud-Trace-Context",
+	"Traceparent",
+}
+
+var idEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// NewRequestID returns a random ID shaped like Nuon's: "req" followed by 23
+// lowercase base32 characters (26 characters in total).
+func NewRequestID() string {
+	b := make([]byte, 15)
+	rand.Read(b)
+	return "req" + idEncoding.EncodeToString(b)[:23]
+}
+
+// serverRequestID returns the first server-assigned ID found in h that
+// differs from the ID we sent (servers commonly echo X-Request-ID back).
+func serverRequestID(h http.Header, sent string) string {
+	for _, name := range serverIDHeaders {
+		if v := strings.TrimSpace(h.Get(name)); v != "" && v != sent {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,69 @@
+// Package timing records when each phase of an HTTP request happened, via
+// httptrace. The client's --trace output and the HAR recorder both read it.
+package timing
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http/httptrace"
+	"time"
+)
+
+// Timer holds the timestamps of one request. Phases that did not happen (a
+// reused connection, a response served from the cache or a cassette) stay
+// zero.
+type Timer struct {
+	Start, End                time.Time
+	DNSStart, DNSDone         time.Time
+	ConnectStart, ConnectDone time.Time
+	TLSStart, TLSDone         time.Time
+	GotConn, WroteRequest     time.Time
+	FirstByte                 time.Time
+
+	Reused bool                 // the connection was reused
+	Remote string               // remote address of the connection, host:port
+	TLS    *tls.ConnectionState // state of the TLS handshake, if one was made
+}
+
+// Start returns a Timer started now.
+func Start() *Timer {
+	return &Timer{Start: time.Now()}
+}
+
+// Stop records the end of the exchange.
+func (t *Timer) Stop() {
+	t.End = time.Now()
+}
+
+// WithContext returns ctx with httptrace hooks that fill in t.
+func (t *Timer) WithContext(ctx context.Context) context.Context {
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		DNSStart:          func(httptrace.DNSStartInfo) { t.DNSStart = time.Now() },
+		DNSDone:           func(httptrace.DNSDoneInfo) { t.DNSDone = time.Now() },
+		ConnectStart:      func(_, _ string) { t.ConnectStart = time.Now() },
+		ConnectDone:       func(_, _ string, _ error) { t.ConnectDone = time.Now() },
+		TLSHandshakeStart: func() { t.TLSStart = time.Now() },
+		TLSHandshakeDone: func(s tls.ConnectionState, _ error) {
+			t.TLSDone = time.Now()
+			t.TLS = &s
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.GotConn = time.Now()
+			t.Reused = info.Reused
+			if info.Conn != nil {
+				t.Remote = info.Conn.RemoteAddr().String()
+			}
+		},
+		WroteRequest:         func(httptrace.WroteRequestInfo) { t.WroteRequest = time.Now() },
+		GotFirstResponseByte: func() { t.FirstByte = time.Now() },
+	})
+}
+
+// RemoteIP is the IP address of Remote, or Remote itself for unix sockets.
+func (t *Timer) RemoteIP() string {
+	if host, _, err := net.SplitHostPort(t.Remote); err == nil {
+		return host
+	}
+	return t.Remote
+}
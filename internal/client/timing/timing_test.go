@@ -0,0 +1,45 @@
+package timing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTimerRecordsPhases(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	timer := Start()
+	req, _ := http.NewRequestWithContext(timer.WithContext(context.Background()), "GET", srv.URL, nil)
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	timer.Stop()
+
+	if timer.ConnectStart.IsZero() || timer.GotConn.IsZero() || timer.FirstByte.IsZero() {
+		t.Fatalf("expected connect, conn and first byte times, got %+v", timer)
+	}
+	if timer.FirstByte.Before(timer.GotConn) || timer.End.Before(timer.FirstByte) {
+		t.Fatalf("expected phases in order, got %+v", timer)
+	}
+	if timer.Reused || timer.RemoteIP() != "127.0.0.1" {
+		t.Fatalf("expected a new connection to 127.0.0.1, got reused=%t remote=%q", timer.Reused, timer.Remote)
+	}
+}
+
+func TestRemoteIP(t *testing.T) {
+	for remote, want := range map[string]string{
+		"127.0.0.1:443":      "127.0.0.1",
+		"[::1]:8080":         "::1",
+		"/run/nuon/api.sock": "/run/nuon/api.sock",
+		"":                   "",
+	} {
+		if got := (&Timer{Remote: remote}).RemoteIP(); got != want {
+			t.Fatalf("RemoteIP() for %q: expected %q, got %q", remote, want, got)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/nuonco/nuon-ext-api/internal/client/timing"
+	"github.com/nuonco/nuon-ext-api/internal/debug"
+	"github.com/nuonco/nuon-ext-api/internal/redact"
+)
+
+// tracer dumps a single request and its timings to the debug trace.
+type tracer struct {
+	*timing.Timer
+}
+
+func newTracer() *tracer {
+	return &tracer{Timer: timing.Start()}
+}
+
+// request dumps the outgoing request line, headers and body.
+func (t *tracer) request(req *http.Request, payload string) {
+	debug.Trace("> %s %s %s", req.Method, req.URL.RequestURI(), req.Proto)
+	debug.Trace("> Host: %s", req.URL.Host)
+	traceHeaders(">", req.Header)
+	traceBody(">", []byte(payload))
+}
+
+// response dumps the response status, headers and body, followed by the
+// timing breakdown. Phases that did not happen (a reused connection, a
+// response served from the cache or a cassette) are left out.
+func (t *tracer) response(resp *http.Response, body []byte) {
+	t.Stop()
+
+	debug.Trace("< %s %s", resp.Proto, resp.Status)
+	traceHeaders("<", resp.Header)
+	traceBody("<", body)
+
+	if t.Remote != "" {
+		conn := "new connection"
+		if t.Reused {
+			conn = "reused connection"
+		}
+		debug.Trace("* %s to %s", conn, t.Remote)
+	}
+	if t.TLS != nil {
+		debug.Trace("* %s, cipher %s", tls.VersionName(t.TLS.Version), tls.CipherSuiteName(t.TLS.CipherSuite))
+	}
+	tracePhase("dns", t.DNSStart, t.DNSDone)
+	tracePhase("connect", t.ConnectStart, t.ConnectDone)
+	tracePhase("tls", t.TLSStart, t.TLSDone)
+	if !t.FirstByte.IsZero() {
+		sent := t.WroteRequest
+		if sent.IsZero() {
+			sent = t.Start
+		}
+		debug.Trace("* %-8s %s", "ttfb", t.FirstByte.Sub(sent).Round(time.Microsecond))
+		debug.Trace("* %-8s %s", "download", t.End.Sub(t.FirstByte).Round(time.Microsecond))
+	}
+	debug.Trace("* %-8s %s", "total", t.End.Sub(t.Start).Round(time.Microsecond))
+}
+
+func tracePhase(name string, start, done time.Time) {
+	if start.IsZero() || done.IsZero() {
+		return
+	}
+	debug.Trace("* %-8s %s", name, done.Sub(start).Round(time.Microsecond))
+}
+
+func traceHeaders(dir string, h http.Header) {
+	h = redact.Header(h)
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, v := range h[name] {
+			debug.Trace("%s %s: %s", dir, name, v)
+		}
+	}
+}
+
+func traceBody(dir string, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+	if !utf8.Valid(body) {
+		debug.Trace("%s [%d bytes of binary data]", dir, len(body))
+		return
+	}
+	debug.Trace("%s", dir)
+	for _, line := range strings.Split(strings.TrimRight(string(redact.JSON(body)), "\n"), "\n") {
+		debug.Trace("%s %s", dir, line)
+	}
+	debug.Trace("%s [%s]", dir, byteCount(len(body)))
+}
+
+func byteCount(n int) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MiB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KiB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%d bytes", n)
+	}
+}
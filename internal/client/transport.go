@@ -0,0 +1,329 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nuonco/nuon-ext-api/internal/cache"
+	"github.com/nuonco/nuon-ext-api/internal/cassette"
+	"github.com/nuonco/nuon-ext-api/internal/config"
+	"github.com/nuonco/nuon-ext-api/internal/har"
+)
+
+// unixScheme marks API URLs that point at a local unix socket, e.g.
+// unix:///var/run/nuon-api.sock.
+const unixScheme = "unix://"
+
+// unixBaseURL is the placeholder HTTP origin used for unix socket requests.
+const unixBaseURL = "http://unix"
+
+// dialTimeout bounds establishing a TCP or unix socket connection.
+const dialTimeout = 10 * time.Second
+
+// transport builds the round tripper chain for cfg: replay serves recorded
+// interactions without network access, record saves every exchange, and the
+// opt-in cache answers repeated GETs from disk. The HAR recorder sits on
+// top so it logs exactly what the client saw.
+func transport(cfg *config.Config) (http.RoundTripper, error) {
+	var rt http.RoundTripper
+	if cfg.ReplayDir != "" {
+		rt = cassette.NewReplayer(cfg.ReplayDir)
+	} else {
+		base, err := newHTTPTransport(cfg)
+		if err != nil {
+			return nil, err
+		}
+		rt = base
+		if cfg.CacheTTL > 0 && !cfg.NoCache {
+			rt = cache.New(filepath.Join(cfg.StateDir(), "cache"), cfg.CacheTTL, rt)
+		}
+		if cfg.RecordDir != "" {
+			rt = cassette.NewRecorder(cfg.RecordDir, rt)
+		}
+	}
+
+	if cfg.HARFile != "" {
+		rt = har.NewRecorder(cfg.HARFile, rt)
+	}
+	return rt, nil
+}
+
+// newHTTPTransport applies the TLS, proxy and unix socket settings from cfg
+// to a copy of http.DefaultTransport.
+func newHTTPTransport(cfg *config.Config) (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	t.TLSClientConfig = tlsConfig
+
+	if socket, ok := unixSocketPath(cfg.APIURL); ok {
+		dialer := &net.Dialer{Timeout: dialTimeout}
+		t.Proxy = nil
+		t.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", socket)
+		}
+		return t, nil
+	}
+
+	proxy, err := proxyFunc(cfg)
+	if err != nil {
+		return nil, err
+	}
+	t.Proxy = proxy
+	return t, nil
+}
+
+// newTLSConfig loads the CA bundle and client certificate from cfg.
+func newTLSConfig(cfg *config.Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA file %s contains no PEM certificates", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	switch {
+	case cfg.ClientCert != "" && cfg.ClientKey != "":
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	case cfg.ClientCert != "" || cfg.ClientKey != "":
+		return nil, fmt.Errorf("client certificate and key must be set together (NUON_API_CLIENT_CERT, NUON_API_CLIENT_KEY)")
+	}
+
+	if cfg.Insecure {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	return tlsConfig, nil
+}
+
+// proxyFunc returns the explicit proxy from cfg, or the environment's.
+func proxyFunc(cfg *config.Config) (func(*http.Request) (*neturl.URL, error), error) {
+	if cfg.ProxyURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	u, err := neturl.Parse(cfg.ProxyURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q", cfg.ProxyURL)
+	}
+	return http.ProxyURL(u), nil
+}
+
+// unixSocketPath extracts the socket path from a unix:// API URL.
+func unixSocketPath(apiURL string) (string, bool) {
+	if !strings.HasPrefix(apiURL, unixScheme) {
+		return "", false
+	}
+	return strings.TrimPrefix(apiURL, unixScheme), true
+}
+
+// baseURL returns the HTTP origin requests are built against.
+func baseURL(apiURL string) string {
+	if _, ok := unixSocketPath(apiURL); ok {
+		return unixBaseURL
+	}
+	return strings.TrimRight(apiURL, "/")
+}
+
+// ConnectionReport describes the outcome of CheckConnection.
+type ConnectionReport struct {
+	Network     string        // "tcp" or "unix"
+	Address     string        // host:port or socket path that was dialed
+	Proxy       string        // proxy URL, if one applies to the API URL
+	TLS         bool          // whether a TLS handshake was performed
+	TLSVersion  string        // negotiated TLS version
+	ServerName  string        // SNI / verified name
+	PeerSubject string        // leaf certificate subject
+	PeerIssuer  string        // leaf certificate issuer
+	PeerExpiry  time.Time     // leaf certificate NotAfter
+	ClientCert  bool          // whether a client certificate was offered
+	Insecure    bool          // whether verification was skipped
+	Elapsed     time.Duration // time to connect (and handshake)
+}
+
+// CheckConnection validates the transport configuration by dialing the API
+// endpoint and, for https, completing a TLS handshake with the configured CA,
+// client certificate and verification settings. When a proxy applies, the
+// handshake runs through a CONNECT tunnel opened by the proxy. No HTTP request
+// is sent to the API.
+func CheckConnection(ctx context.Context, cfg *config.Config) (*ConnectionReport, error) {
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ConnectionReport{
+		Insecure:   cfg.Insecure,
+		ClientCert: len(tlsConfig.Certificates) > 0,
+	}
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	start := time.Now()
+
+	if socket, ok := unixSocketPath(cfg.APIURL); ok {
+		report.Network, report.Address = "unix", socket
+		conn, err := dialer.DialContext(ctx, "unix", socket)
+		if err != nil {
+			return report, fmt.Errorf("connecting to %s: %w", socket, err)
+		}
+		conn.Close()
+		report.Elapsed = time.Since(start)
+		return report, nil
+	}
+
+	u, err := neturl.Parse(cfg.APIURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid API URL %q", cfg.APIURL)
+	}
+	report.Network = "tcp"
+	report.Address = hostPort(u)
+
+	proxy, err := proxyFunc(cfg)
+	if err != nil {
+		return nil, err
+	}
+	proxyURL, err := proxy(&http.Request{URL: u})
+	if err != nil {
+		return nil, fmt.Errorf("resolving proxy: %w", err)
+	}
+
+	var conn net.Conn
+	if proxyURL != nil {
+		report.Proxy = proxyURL.Redacted()
+		conn, err = dialProxy(ctx, dialer, proxyURL, tlsConfig)
+		if err != nil {
+			return report, err
+		}
+		// Plain http requests are forwarded by the proxy without a tunnel.
+		if u.Scheme == "https" {
+			if err := connectTunnel(ctx, conn, proxyURL, report.Address); err != nil {
+				conn.Close()
+				return report, err
+			}
+		}
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", report.Address)
+		if err != nil {
+			return report, fmt.Errorf("connecting to %s: %w", report.Address, err)
+		}
+	}
+	defer conn.Close()
+
+	if u.Scheme != "https" {
+		report.Elapsed = time.Since(start)
+		return report, nil
+	}
+
+	tlsConfig.ServerName = u.Hostname()
+	tlsConn := tls.Client(conn, tlsConfig)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return report, fmt.Errorf("TLS handshake with %s: %w", report.Address, err)
+	}
+
+	state := tlsConn.ConnectionState()
+	report.TLS = true
+	report.TLSVersion = tls.VersionName(state.Version)
+	report.ServerName = tlsConfig.ServerName
+	if len(state.PeerCertificates) > 0 {
+		leaf := state.PeerCertificates[0]
+		report.PeerSubject = leaf.Subject.String()
+		report.PeerIssuer = leaf.Issuer.String()
+		report.PeerExpiry = leaf.NotAfter
+	}
+	report.Elapsed = time.Since(start)
+	return report, nil
+}
+
+// hostPort returns u's host and port, defaulting the port by scheme.
+func hostPort(u *neturl.URL) string {
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+// dialProxy connects to an http or https proxy. An https proxy is verified
+// with the same TLS settings as the API, as http.Transport does.
+func dialProxy(ctx context.Context, dialer *net.Dialer, proxyURL *neturl.URL, tlsConfig *tls.Config) (net.Conn, error) {
+	if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" {
+		return nil, fmt.Errorf("checking a %s proxy is not supported", proxyURL.Scheme)
+	}
+	addr := hostPort(proxyURL)
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to proxy %s: %w", proxyURL.Redacted(), err)
+	}
+	if proxyURL.Scheme == "http" {
+		return conn, nil
+	}
+
+	proxyTLS := tlsConfig.Clone()
+	proxyTLS.ServerName = proxyURL.Hostname()
+	tlsConn := tls.Client(conn, proxyTLS)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("TLS handshake with proxy %s: %w", proxyURL.Redacted(), err)
+	}
+	return tlsConn, nil
+}
+
+// connectTunnel asks the proxy on conn to open a tunnel to addr.
+func connectTunnel(ctx context.Context, conn net.Conn, proxyURL *neturl.URL, addr string) error {
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &neturl.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if u := proxyURL.User; u != nil {
+		password, _ := u.Password()
+		req.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(u.Username()+":"+password)))
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+	if err := req.Write(conn); err != nil {
+		return fmt.Errorf("CONNECT %s via proxy %s: %w", addr, proxyURL.Redacted(), err)
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		return fmt.Errorf("CONNECT %s via proxy %s: %w", addr, proxyURL.Redacted(), err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("CONNECT %s via proxy %s: %s", addr, proxyURL.Redacted(), resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nuonco/nuon-ext-api/internal/config"
+)
+
+// writeCAFile stores the test server's certificate as a PEM bundle.
+func writeCAFile(t *testing.T, srv *httptest.Server) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(path, pemData, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestNewTrustsCAFile(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	untrusted := newTestClient(t, &config.Config{APIURL: srv.URL})
+	if _, err := untrusted.Do(context.Background(), "GET", "/v1/apps", ""); err == nil {
+		t.Fatal("expected an unknown authority error without a CA file")
+	}
+
+	c := newTestClient(t, &config.Config{APIURL: srv.URL, CAFile: writeCAFile(t, srv)})
+	resp, err := c.Do(context.Background(), "GET", "/v1/apps", "")
+	if err != nil {
+		t.Fatalf("Do() returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestNewRejectsHalfConfiguredClientCert(t *testing.T) {
+	if _, err := New(&config.Config{APIURL: "https://api.example.com", ClientCert: "cert.pem"}); err == nil {
+		t.Fatal("expected an error when only the client certificate is set")
+	}
+}
+
+func TestDoOverUnixSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "api.sock")
+	ln, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Skipf("unix sockets unavailable: %v", err)
+	}
+	srv := &httptest.Server{
+		Listener: ln,
+		Config: &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(r.URL.Path))
+		})},
+	}
+	srv.Start()
+	defer srv.Close()
+
+	c := newTestClient(t, &config.Config{APIURL: "unix://" + socket})
+	resp, err := c.Do(context.Background(), "GET", "/v1/apps", "")
+	if err != nil {
+		t.Fatalf("Do() returned error: %v", err)
+	}
+	if string(resp.Body) != "/v1/apps" {
+		t.Fatalf("expected echoed path, got %q", resp.Body)
+	}
+}
+
+func TestCheckConnectionCompletesTLSHandshake(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("CheckConnection must not send an HTTP request")
+	}))
+	defer srv.Close()
+
+	report, err := CheckConnection(context.Background(), &config.Config{APIURL: srv.URL, CAFile: writeCAFile(t, srv)})
+	if err != nil {
+		t.Fatalf("CheckConnection() returned error: %v", err)
+	}
+	if !report.TLS || report.TLSVersion == "" {
+		t.Fatalf("expected a completed TLS handshake, got %+v", report)
+	}
+}
+
+func TestCheckConnectionTunnelsThroughProxy(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("CheckConnection must not send an HTTP request")
+	}))
+	defer srv.Close()
+
+	var connects int
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "CONNECT only", http.StatusMethodNotAllowed)
+			return
+		}
+		connects++
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		client, buf, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			upstream.Close()
+			return
+		}
+		client.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		go func() {
+			io.Copy(upstream, buf)
+			upstream.Close()
+		}()
+		io.Copy(client, upstream)
+		client.Close()
+	}))
+	defer proxy.Close()
+
+	cfg := &config.Config{APIURL: srv.URL, ProxyURL: proxy.URL, CAFile: writeCAFile(t, srv)}
+	report, err := CheckConnection(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("CheckConnection() returned error: %v", err)
+	}
+	if connects != 1 || !report.TLS || report.Proxy == "" {
+		t.Fatalf("expected a TLS handshake through one CONNECT tunnel, got %+v (%d CONNECTs)", report, connects)
+	}
+
+	cfg.CAFile = ""
+	if _, err := CheckConnection(context.Background(), cfg); err == nil {
+		t.Fatal("expected certificate verification to run through the proxy")
+	}
+}
+
+func TestHostPortDefaultsByScheme(t *testing.T) {
+	for raw, want := range map[string]string{
+		"https://proxy.corp":      "proxy.corp:443",
+		"http://proxy.corp":       "proxy.corp:80",
+		"https://proxy.corp:8443": "proxy.corp:8443",
+	} {
+		u, _ := neturl.Parse(raw)
+		if got := hostPort(u); got != want {
+			t.Fatalf("hostPort(%s) = %q, want %q", raw, got, want)
+		}
+	}
+}
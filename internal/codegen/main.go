@@ -0,0 +1,442 @@
+// Command codegen generates the typed client in pkg/nuonclient from the
+// embedded swagger spec. Run it with `go generate ./pkg/nuonclient`.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+func main() {
+	out := flag.String("o", "generated.go", "output file")
+	pkg := flag.String("package", "nuonclient", "package name")
+	flag.Parse()
+
+	api, err := spec.Parse()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	src, err := generate(api, *pkg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// generator renders one definition or operation at a time into buf.
+type generator struct {
+	api   *spec.API
+	buf   bytes.Buffer
+	names map[string]string // definition name → Go type name
+}
+
+// generate renders a struct (or named type) per definition and a method per
+// operation, then gofmts the result.
+func generate(api *spec.API, pkg string) ([]byte, error) {
+	g := &generator{api: api, names: typeNames(api.Definitions)}
+
+	defs := make([]string, 0, len(api.Definitions))
+	for name := range api.Definitions {
+		defs = append(defs, name)
+	}
+	sort.Slice(defs, func(i, j int) bool { return g.names[defs[i]] < g.names[defs[j]] })
+	for _, name := range defs {
+		g.definition(name, api.Definitions[name])
+	}
+
+	routes := append([]spec.Route(nil), api.Routes...)
+	sort.Slice(routes, func(i, j int) bool { return operationName(routes[i]) < operationName(routes[j]) })
+	for _, r := range routes {
+		g.operation(r)
+	}
+
+	body := g.buf.Bytes()
+	var head bytes.Buffer
+	head.WriteString("// Code generated by internal/codegen from spec/doc.json. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&head, "package %s\n\nimport (\n\"context\"\n", pkg)
+	for _, imp := range []struct{ path, use string }{
+		{"encoding/json", "json.RawMessage"},
+		{"net/url", "url."},
+		{"strconv", "strconv."},
+	} {
+		if bytes.Contains(body, []byte(imp.use)) {
+			fmt.Fprintf(&head, "%q\n", imp.path)
+		}
+	}
+	head.WriteString(")\n\n")
+
+	src, err := format.Source(append(head.Bytes(), body...))
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// comment writes text as a // comment block.
+func (g *generator) comment(text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(text, "\n") {
+		g.printf("// %s\n", strings.TrimRight(line, " \t"))
+	}
+}
+
+func (g *generator) definition(name string, s *spec.Schema) {
+	typeName := g.names[name]
+	switch {
+	case s.Type == "string" && len(s.Enum) > 0:
+		g.comment(fmt.Sprintf("%s is %s.", typeName, name))
+		g.printf("type %s string\n\n", typeName)
+		g.enumConsts(typeName, s.Enum)
+	case len(s.Properties) > 0:
+		if s.Description != "" {
+			g.comment(s.Description)
+		} else {
+			g.comment(fmt.Sprintf("%s is %s.", typeName, name))
+		}
+		g.printf("type %s struct {\n", typeName)
+		g.fields(s)
+		g.printf("}\n\n")
+	default:
+		g.comment(fmt.Sprintf("%s is %s.", typeName, name))
+		g.printf("type %s %s\n\n", typeName, g.goType(s, false))
+	}
+}
+
+func (g *generator) enumConsts(typeName string, values []any) {
+	seen := make(map[string]bool)
+	g.printf("const (\n")
+	for _, v := range values {
+		str, ok := v.(string)
+		if !ok || str == "" {
+			continue
+		}
+		constName := typeName + exportedName(str)
+		if seen[constName] {
+			continue
+		}
+		seen[constName] = true
+		g.printf("%s %s = %s\n", constName, typeName, strconv.Quote(str))
+	}
+	g.printf(")\n\n")
+}
+
+func (g *generator) fields(s *spec.Schema) {
+	props := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		props = append(props, name)
+	}
+	sort.Strings(props)
+
+	required := make(map[string]bool, len(s.Required))
+	for _, r := range s.Required {
+		required[r] = true
+	}
+
+	used := make(map[string]bool)
+	for _, prop := range props {
+		fieldName := exportedName(prop)
+		for used[fieldName] {
+			fieldName += "_"
+		}
+		used[fieldName] = true
+
+		ps := s.Properties[prop]
+		g.comment(ps.Description)
+		tag := prop
+		if !required[prop] {
+			tag += ",omitempty"
+		}
+		g.printf("%s %s `json:%q`\n", fieldName, g.goType(ps, true), tag)
+	}
+}
+
+// goType maps a schema to a Go type. Fields refer to other structs through
+// pointers so that optional and recursive definitions work.
+func (g *generator) goType(s *spec.Schema, field bool) string {
+	if s == nil {
+		return "json.RawMessage"
+	}
+	if s.Ref != "" {
+		name := spec.DefinitionName(s.Ref)
+		typeName, ok := g.names[name]
+		if !ok {
+			return "json.RawMessage"
+		}
+		if def := g.api.Definitions[name]; field && def != nil && len(def.Properties) > 0 {
+			return "*" + typeName
+		}
+		return typeName
+	}
+	if len(s.AllOf) == 1 {
+		return g.goType(s.AllOf[0], field)
+	}
+
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "file":
+		return "[]byte"
+	case "array":
+		return "[]" + g.goType(s.Items, false)
+	case "object":
+		if s.AdditionalProperties != nil && (s.AdditionalProperties.Type != "" || s.AdditionalProperties.Ref != "") {
+			return "map[string]" + g.goType(s.AdditionalProperties, false)
+		}
+		return "map[string]any"
+	}
+	return "any"
+}
+
+func (g *generator) operation(r spec.Route) {
+	name := operationName(r)
+
+	pathParams := orderedPathParams(r)
+	args := []string{"ctx context.Context"}
+	paramMap := make([]string, 0, len(pathParams))
+	for _, p := range pathParams {
+		arg := argName(p)
+		args = append(args, arg+" string")
+		paramMap = append(paramMap, fmt.Sprintf("%q: %s", p, arg))
+	}
+
+	bodyType := ""
+	if r.HasBody {
+		bodyType = g.goType(r.Body, true)
+		if !strings.HasPrefix(bodyType, "*") && !strings.HasPrefix(bodyType, "[]") && !strings.HasPrefix(bodyType, "map[") {
+			bodyType = "any"
+		}
+		args = append(args, "body "+bodyType)
+	}
+
+	paramsType := ""
+	if len(r.QueryParams) > 0 {
+		paramsType = name + "Params"
+		g.queryParams(paramsType, r)
+		args = append(args, "params *"+paramsType)
+	}
+
+	outType := ""
+	raw := r.Accept() != "application/json"
+	switch {
+	case raw:
+		outType = "[]byte"
+	case r.Response != nil && (r.Response.Ref != "" || r.Response.Type != ""):
+		outType = g.goType(r.Response, false)
+	}
+
+	summary := r.Summary
+	if summary == "" {
+		summary = r.DisplayName()
+	}
+	g.comment(fmt.Sprintf("%s calls %s.\n\n%s", name, r.DisplayName(), summary))
+	if r.Deprecated {
+		g.printf("//\n// Deprecated: the endpoint is deprecated in the API.\n")
+	}
+
+	pathParamsExpr := "nil"
+	if len(paramMap) > 0 {
+		pathParamsExpr = "map[string]string{" + strings.Join(paramMap, ", ") + "}"
+	}
+	queryExpr := "nil"
+	if paramsType != "" {
+		queryExpr = "params.values()"
+	}
+	bodyExpr := "nil"
+	if bodyType != "" {
+		bodyExpr = "body"
+	}
+	g.printf("func (c *Client) %s(%s) ", name, strings.Join(args, ", "))
+	call := fmt.Sprintf("c.do(ctx, %q, %q, %s, %s, %s", r.Method, r.Path, pathParamsExpr, queryExpr, bodyExpr)
+
+	switch {
+	case outType == "":
+		g.printf("error {\nreturn %s, nil)\n}\n\n", call)
+	case strings.HasPrefix(outType, "[]") || strings.HasPrefix(outType, "map[") || isScalar(outType):
+		g.printf("(%s, error) {\nvar out %s\nerr := %s, &out)\nreturn out, err\n}\n\n", outType, outType, call)
+	default:
+		g.printf("(*%s, error) {\nvar out %s\nif err := %s, &out); err != nil {\nreturn nil, err\n}\nreturn &out, nil\n}\n\n", outType, outType, call)
+	}
+}
+
+func (g *generator) queryParams(typeName string, r spec.Route) {
+	g.comment(fmt.Sprintf("%s holds the query parameters of %s. Zero values are not sent.", typeName, r.DisplayName()))
+	g.printf("type %s struct {\n", typeName)
+	for _, p := range r.QueryParams {
+		g.comment(p.Description)
+		g.printf("%s %s\n", exportedName(p.Name), queryGoType(p.Type))
+	}
+	g.printf("}\n\n")
+
+	g.printf("func (p *%s) values() url.Values {\nq := url.Values{}\nif p == nil {\nreturn q\n}\n", typeName)
+	for _, p := range r.QueryParams {
+		field := "p." + exportedName(p.Name)
+		switch queryGoType(p.Type) {
+		case "int64":
+			g.printf("if %s != 0 {\nq.Set(%q, strconv.FormatInt(%s, 10))\n}\n", field, p.Name, field)
+		case "bool":
+			g.printf("if %s {\nq.Set(%q, \"true\")\n}\n", field, p.Name)
+		case "float64":
+			g.printf("if %s != 0 {\nq.Set(%q, strconv.FormatFloat(%s, 'f', -1, 64))\n}\n", field, p.Name, field)
+		default:
+			g.printf("if %s != \"\" {\nq.Set(%q, %s)\n}\n", field, p.Name, field)
+		}
+	}
+	g.printf("return q\n}\n\n")
+}
+
+func queryGoType(t string) string {
+	switch t {
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	}
+	return "string"
+}
+
+func isScalar(t string) bool {
+	switch t {
+	case "string", "bool", "int64", "float64", "any", "json.RawMessage":
+		return true
+	}
+	return false
+}
+
+// orderedPathParams returns the placeholders in the order they appear in the path.
+func orderedPathParams(r spec.Route) []string {
+	var params []string
+	for _, seg := range strings.Split(r.Path, "/") {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			params = append(params, seg[1:len(seg)-1])
+		}
+	}
+	return params
+}
+
+// operationName is the route's operationId, or a name derived from the
+// method and path for the few operations without one.
+func operationName(r spec.Route) string {
+	if r.OperationID != "" {
+		return exportedName(r.OperationID)
+	}
+	name := strings.ToLower(r.Method)
+	for _, seg := range strings.Split(r.Path, "/") {
+		name += "_" + strings.Trim(seg, "{}")
+	}
+	return exportedName(name)
+}
+
+// typeNames assigns a unique Go type name to every definition, e.g.
+// app.ActionWorkflowConfig → AppActionWorkflowConfig.
+func typeNames(defs map[string]*spec.Schema) map[string]string {
+	keys := make([]string, 0, len(defs))
+	for name := range defs {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+
+	names := make(map[string]string, len(defs))
+	used := make(map[string]bool, len(defs))
+	for _, name := range keys {
+		typeName := exportedName(strings.TrimPrefix(name, "github_com_nuonco_nuon_pkg_"))
+		for used[typeName] {
+			typeName += "_"
+		}
+		used[typeName] = true
+		names[name] = typeName
+	}
+	return names
+}
+
+// initialisms are upper-cased in generated names, following Go style.
+var initialisms = map[string]bool{
+	"acl": true, "api": true, "arn": true, "aws": true, "cpu": true, "dns": true,
+	"gcp": true, "http": true, "https": true, "iam": true, "id": true, "ids": true,
+	"ip": true, "json": true, "oci": true, "os": true, "sql": true, "ssh": true,
+	"tls": true, "ttl": true, "ui": true, "uri": true, "url": true, "uuid": true,
+	"vcs": true, "vpc": true,
+}
+
+// exportedName converts snake_case, dotted and kebab-case identifiers into
+// an exported Go identifier. Existing CamelCase is kept.
+func exportedName(s string) string {
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var b strings.Builder
+	for _, part := range parts {
+		lower := strings.ToLower(part)
+		if initialisms[lower] {
+			if lower == "ids" {
+				b.WriteString("IDs")
+			} else {
+				b.WriteString(strings.ToUpper(lower))
+			}
+			continue
+		}
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	name := b.String()
+	if name == "" {
+		return "X"
+	}
+	if unicode.IsDigit([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+// argName turns a path placeholder into a method argument, e.g. app_id → appID.
+func argName(param string) string {
+	name := exportedName(param)
+	for i, r := range name {
+		if !unicode.IsUpper(r) {
+			if i > 1 {
+				i--
+			}
+			name = strings.ToLower(name[:i]) + name[i:]
+			break
+		}
+		if i == len(name)-1 {
+			name = strings.ToLower(name)
+		}
+	}
+	switch name {
+	case "ctx", "body", "params", "c", "out", "err", "type", "func", "range", "map", "var":
+		name += "Param"
+	}
+	return name
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+func TestGeneratedClientIsUpToDate(t *testing.T) {
+	api, err := spec.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := generate(api, "nuonclient")
+	if err != nil {
+		t.Fatalf("generate() returned error: %v", err)
+	}
+
+	got, err := os.ReadFile("../../pkg/nuonclient/generated.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatal("pkg/nuonclient/generated.go is stale; run go generate ./pkg/nuonclient")
+	}
+}
+
+func TestExportedName(t *testing.T) {
+	cases := map[string]string{
+		"app.ActionWorkflowConfig": "AppActionWorkflowConfig",
+		"vcs_connection_id":        "VCSConnectionID",
+		"component_dependency_ids": "ComponentDependencyIDs",
+		"in-progress":              "InProgress",
+	}
+	for in, want := range cases {
+		if got := exportedName(in); got != want {
+			t.Fatalf("exportedName(%q): expected %q, got %q", in, want, got)
+		}
+	}
+	if got := argName("vcs_connection_id"); got != "vcsConnectionID" {
+		t.Fatalf("argName: expected %q, got %q", "vcsConnectionID", got)
+	}
+}
@@ -1,22 +1,71 @@
 package config
 
 import (
+	"fmt"
+	"maps"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/nuonco/nuon-ext-api/internal/debug"
 )
 
 type Config struct {
-	APIURL     string
-	APIToken   string
-	OrgID      string
-	AppID      string
-	InstallID  string
-	ConfigFile string
-	ExtName    string
-	ExtDir     string
+	APIURL       string
+	APIToken     string
+	OrgID        string
+	AppID        string
+	InstallID    string
+	ConfigFile   string
+	ExtName      string
+	ExtDir       string
+	Timeout      time.Duration // per-request timeout; zero disables it
+	RecordDir    string        // save HTTP interactions to this directory
+	ReplayDir    string        // serve HTTP interactions from this directory instead of the network
+	CacheTTL     time.Duration // cache GET responses on disk for this long; zero disables the cache
+	NoCache      bool          // bypass the response cache entirely
+	RequestID    string        // X-Request-ID to send instead of a generated one
+	HARFile      string        // write every exchange to this HAR file
+	NoInput      bool          // never prompt; unresolved placeholders are an error
+	Last         bool          // reuse the most recent selection instead of prompting
+	Multi        bool          // let the selector pick several values and run the request for each
+	NoNameLookup bool          // use path values as given; never look names up
+	StrictIDs    bool          // fail instead of warning when an ID's prefix fits another param
+
+	// Params holds values for path placeholders given with -p name=value.
+	Params map[string]string
+	// ParamsFromEnv resolves any {name} placeholder from NUON_<NAME>
+	// (NUON_WORKFLOW_ID → {workflow_id}); see EnvParam.
+	ParamsFromEnv bool
+
+	// Profile selects the saved context (`nuon api context`) to use.
+	Profile string
+	// Context holds the profile's default path parameter values by name,
+	// consulted after -p flags and env vars.
+	Context map[string]string
+
+	// ListEndpoints overrides the endpoint used to list candidates for a path
+	// parameter, e.g. "step_id" → "/v1/workflows/{workflow_id}/steps".
+	ListEndpoints map[string]string
+
+	// IDPrefixes declares the ID prefixes of path parameters, e.g.
+	// "install_id" → ["ins"], in addition to the prefixes learned from
+	// responses.
+	IDPrefixes map[string][]string
+
+	// Transport settings. APIURL may also be unix:///path/to.sock.
+	CAFile     string // PEM bundle trusted in addition to the system roots
+	ClientCert string // PEM client certificate for mTLS
+	ClientKey  string // PEM private key for ClientCert
+	Insecure   bool   // skip TLS certificate verification
+	ProxyURL   string // explicit proxy; HTTPS_PROXY/NO_PROXY apply when empty
 }
 
+// DefaultTimeout bounds a single HTTP request when NUON_API_TIMEOUT is unset.
+const DefaultTimeout = 60 * time.Second
+
 func Load() *Config {
 	cfg := &Config{
 		APIURL:     os.Getenv("NUON_API_URL"),
@@ -27,17 +76,243 @@ func Load() *Config {
 		ConfigFile: os.Getenv("NUON_CONFIG_FILE"),
 		ExtName:    os.Getenv("NUON_EXT_NAME"),
 		ExtDir:     os.Getenv("NUON_EXT_DIR"),
+		CAFile:     os.Getenv("NUON_API_CA_FILE"),
+		ClientCert: os.Getenv("NUON_API_CLIENT_CERT"),
+		ClientKey:  os.Getenv("NUON_API_CLIENT_KEY"),
+		Insecure:   os.Getenv("NUON_API_INSECURE") == "true",
+		ProxyURL:   os.Getenv("NUON_API_PROXY"),
 	}
 	if cfg.APIURL == "" {
 		cfg.APIURL = "https://api.nuon.co"
 	}
+	cfg.ParamsFromEnv = true
+	cfg.Profile = os.Getenv("NUON_API_PROFILE")
 
-	debug.Log("config: api_url=%s org_id=%s app_id=%s install_id=%s token=%s",
-		cfg.APIURL, cfg.OrgID, cfg.AppID, cfg.InstallID, maskToken(cfg.APIToken))
+	cfg.Timeout = DefaultTimeout
+	if v := os.Getenv("NUON_API_TIMEOUT"); v != "" {
+		timeout, err := ParseDuration(v)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: ignoring NUON_API_TIMEOUT: %v\n", err)
+		} else {
+			cfg.Timeout = timeout
+		}
+	}
+
+	if v := os.Getenv("NUON_API_CACHE_TTL"); v != "" {
+		ttl, err := ParseDuration(v)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: ignoring NUON_API_CACHE_TTL: %v\n", err)
+		} else {
+			cfg.CacheTTL = ttl
+		}
+	}
+	cfg.NoCache = os.Getenv("NUON_API_NO_CACHE") == "true"
+	cfg.NoInput = os.Getenv("NUON_NO_INPUT") == "true"
+	cfg.StrictIDs = os.Getenv("NUON_API_STRICT_IDS") == "true"
+
+	file := readConfigFile(cfg.ConfigFile)
+	cfg.ListEndpoints = loadMapSetting(file, "api_list_endpoints", ParseListEndpoints)
+	cfg.IDPrefixes = loadMapSetting(file, "api_id_prefixes", ParseIDPrefixes)
+
+	debug.Log("config: api_url=%s org_id=%s app_id=%s install_id=%s token=%s timeout=%s",
+		cfg.APIURL, cfg.OrgID, cfg.AppID, cfg.InstallID, maskToken(cfg.APIToken), cfg.Timeout)
 
 	return cfg
 }
 
+// Org returns the org requests are scoped to: NUON_ORG_ID, or the org saved
+// in the context.
+func (c *Config) Org() string {
+	if c.OrgID != "" {
+		return c.OrgID
+	}
+	return c.Context["org_id"]
+}
+
+// StateDir returns the directory for extension state such as the response
+// cache. It is NUON_EXT_DIR when run by the nuon CLI, otherwise a per-user
+// cache directory.
+func (c *Config) StateDir() string {
+	if c.ExtDir != "" {
+		return c.ExtDir
+	}
+	if dir, err := os.UserCacheDir(); err == nil {
+		return filepath.Join(dir, "nuon", "extensions", "api")
+	}
+	return filepath.Join(os.TempDir(), "nuon-ext-api")
+}
+
+// readConfigFile returns the top-level `key: value` settings of the nuon
+// config file (NUON_CONFIG_FILE, default ~/.nuon). A missing file has none.
+func readConfigFile(path string) map[string]string {
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil
+		}
+		path = filepath.Join(home, ".nuon")
+	} else if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, rest)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			fmt.Fprintf(os.Stderr, "warning: ignoring config file: %v\n", err)
+		}
+		return nil
+	}
+
+	settings := make(map[string]string)
+	for _, line := range strings.Split(string(data), "\n") {
+		// Indented lines belong to nested values, which no setting uses.
+		if line == "" || line[0] == ' ' || line[0] == '\t' || line[0] == '#' {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		settings[strings.TrimSpace(key)] = value
+	}
+	debug.Log("config: read %d settings from %s", len(settings), path)
+	return settings
+}
+
+// loadMapSetting parses a map setting from the config file key, then merges
+// the NUON_<KEY> env var over it so the env var wins per entry.
+func loadMapSetting[V any](file map[string]string, key string, parse func(string) (map[string]V, error)) map[string]V {
+	sources := []struct{ name, value string }{
+		{key + " in config file", file[key]},
+		{"NUON_" + strings.ToUpper(key), os.Getenv("NUON_" + strings.ToUpper(key))},
+	}
+	var merged map[string]V
+	for _, src := range sources {
+		if src.value == "" {
+			continue
+		}
+		m, err := parse(src.value)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: ignoring %s: %v\n", src.name, err)
+			continue
+		}
+		if merged == nil {
+			merged = make(map[string]V, len(m))
+		}
+		maps.Copy(merged, m)
+	}
+	return merged
+}
+
+// ParseDuration parses a duration setting: a Go duration ("30s", "2m") or a
+// bare number of seconds.
+func ParseDuration(v string) (time.Duration, error) {
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, fmt.Errorf("invalid duration %q: must not be negative", v)
+		}
+		return time.Duration(secs) * time.Second, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q (expected e.g. 30s, 5m or a number of seconds)", v)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid duration %q: must not be negative", v)
+	}
+	return d, nil
+}
+
+// EnvParam returns NUON_<NAME> for the placeholder {name}. It is read only
+// when a route has that placeholder, and never from the variables that
+// configure the extension itself (NUON_API_TOKEN, NUON_DEBUG, ...).
+func EnvParam(name string) string {
+	key := ParamEnvVar(name)
+	if isSettingVar(key) {
+		return ""
+	}
+	return os.Getenv(key)
+}
+
+// isSettingVar reports whether key configures the extension or the nuon CLI
+// rather than supplying a path placeholder.
+func isSettingVar(key string) bool {
+	if strings.HasPrefix(key, "NUON_API_") || strings.HasPrefix(key, "NUON_EXT_") {
+		return true
+	}
+	switch key {
+	case "NUON_CONFIG_FILE", "NUON_DEBUG", "NUON_NO_INPUT":
+		return true
+	}
+	return false
+}
+
+// ParamEnvVar returns the environment variable that supplies {name}.
+func ParamEnvVar(name string) string {
+	return "NUON_" + strings.ToUpper(name)
+}
+
+// ParseParams parses -p name=value flags. Braces around the name are optional.
+func ParseParams(flags []string) (map[string]string, error) {
+	params := make(map[string]string, len(flags))
+	for _, f := range flags {
+		name, value, ok := strings.Cut(f, "=")
+		name = strings.Trim(strings.TrimSpace(name), "{}")
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("invalid parameter %q (expected name=value)", f)
+		}
+		params[name] = value
+	}
+	return params, nil
+}
+
+// ParseListEndpoints parses comma-separated param=path pairs, e.g.
+// "step_id=/v1/workflows/{workflow_id}/steps,runner_id=/v1/runners".
+func ParseListEndpoints(v string) (map[string]string, error) {
+	endpoints := make(map[string]string)
+	for _, pair := range strings.Split(v, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		param, path, ok := strings.Cut(pair, "=")
+		param = strings.Trim(strings.TrimSpace(param), "{}")
+		path = strings.TrimSpace(path)
+		if !ok || param == "" || !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("invalid entry %q (expected param=/path)", pair)
+		}
+		endpoints[param] = path
+	}
+	return endpoints, nil
+}
+
+// ParseIDPrefixes parses comma-separated param=prefix pairs, e.g.
+// "app_id=app,install_id=ins". A param may be given several times. A trailing
+// underscore on the prefix is ignored.
+func ParseIDPrefixes(v string) (map[string][]string, error) {
+	prefixes := make(map[string][]string)
+	for _, pair := range strings.Split(v, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		param, prefix, ok := strings.Cut(pair, "=")
+		param = strings.Trim(strings.TrimSpace(param), "{}")
+		prefix = strings.TrimSuffix(strings.TrimSpace(prefix), "_")
+		if !ok || param == "" || prefix == "" {
+			return nil, fmt.Errorf("invalid entry %q (expected param=prefix)", pair)
+		}
+		prefixes[param] = append(prefixes[param], prefix)
+	}
+	return prefixes, nil
+}
+
 func maskToken(token string) string {
 	if token == "" {
 		return "(empty)"
@@ -1,6 +1,22 @@
 package config
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeConfigFile points NUON_CONFIG_FILE at a temp file holding contents.
+func writeConfigFile(t *testing.T, contents string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "nuon.yml")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("NUON_CONFIG_FILE", path)
+}
 
 func TestLoadReadsInstallIDFromEnv(t *testing.T) {
 	t.Setenv("NUON_INSTALL_ID", "inst_123")
@@ -10,3 +26,116 @@ func TestLoadReadsInstallIDFromEnv(t *testing.T) {
 		t.Fatalf("expected InstallID to be %q, got %q", "inst_123", cfg.InstallID)
 	}
 }
+
+func TestLoadReadsTimeoutFromEnv(t *testing.T) {
+	t.Setenv("NUON_API_TIMEOUT", "90")
+
+	cfg := Load()
+	if cfg.Timeout != 90*time.Second {
+		t.Fatalf("expected Timeout to be %s, got %s", 90*time.Second, cfg.Timeout)
+	}
+}
+
+func TestLoadDefaultsTimeoutWhenEnvInvalid(t *testing.T) {
+	t.Setenv("NUON_API_TIMEOUT", "soon")
+
+	cfg := Load()
+	if cfg.Timeout != DefaultTimeout {
+		t.Fatalf("expected Timeout to be %s, got %s", DefaultTimeout, cfg.Timeout)
+	}
+}
+
+func TestParseDurationErrorsNameNoSetting(t *testing.T) {
+	t.Setenv("NUON_API_CACHE_TTL", "soon")
+
+	if cfg := Load(); cfg.CacheTTL != 0 {
+		t.Fatalf("expected an invalid cache TTL to be ignored, got %s", cfg.CacheTTL)
+	}
+	for _, v := range []string{"soon", "-5", "-1m"} {
+		_, err := ParseDuration(v)
+		if err == nil || strings.Contains(err.Error(), "timeout") {
+			t.Fatalf("%s: expected a neutral duration error, got %v", v, err)
+		}
+	}
+}
+
+func TestLoadReadsListEndpointsFromEnv(t *testing.T) {
+	t.Setenv("NUON_API_LIST_ENDPOINTS", "step_id=/v1/workflows/{workflow_id}/steps, {runner_id}=/v1/runners")
+
+	cfg := Load()
+	if cfg.ListEndpoints["step_id"] != "/v1/workflows/{workflow_id}/steps" || cfg.ListEndpoints["runner_id"] != "/v1/runners" {
+		t.Fatalf("unexpected list endpoints: %v", cfg.ListEndpoints)
+	}
+
+	if _, err := ParseListEndpoints("step_id"); err == nil {
+		t.Fatal("expected an error for an entry without a path")
+	}
+}
+
+func TestLoadReadsListEndpointsFromConfigFile(t *testing.T) {
+	writeConfigFile(t, `api_token: secret
+# comment
+api_list_endpoints: "step_id=/v1/steps,runner_id=/v1/runners"
+nested:
+  api_list_endpoints: step_id=/v1/nested
+`)
+	t.Setenv("NUON_API_LIST_ENDPOINTS", "runner_id=/v1/installs/{install_id}/runners")
+
+	cfg := Load()
+	if cfg.ListEndpoints["step_id"] != "/v1/steps" {
+		t.Fatalf("expected step_id from the config file, got %v", cfg.ListEndpoints)
+	}
+	if cfg.ListEndpoints["runner_id"] != "/v1/installs/{install_id}/runners" {
+		t.Fatalf("expected NUON_API_LIST_ENDPOINTS to override runner_id, got %v", cfg.ListEndpoints)
+	}
+}
+
+func TestLoadReadsIDPrefixesFromEnv(t *testing.T) {
+	t.Setenv("NUON_API_ID_PREFIXES", "app_id=app, install_id=ins_,install_id=inl")
+
+	cfg := Load()
+	if got := cfg.IDPrefixes["install_id"]; len(got) != 2 || got[0] != "ins" || got[1] != "inl" {
+		t.Fatalf("unexpected install_id prefixes: %v", cfg.IDPrefixes)
+	}
+
+	if _, err := ParseIDPrefixes("app_id="); err == nil {
+		t.Fatal("expected an error for an entry without a prefix")
+	}
+}
+
+func TestLoadReadsIDPrefixesFromConfigFile(t *testing.T) {
+	writeConfigFile(t, "api_id_prefixes: app_id=app,install_id=ins\n")
+	t.Setenv("NUON_API_ID_PREFIXES", "install_id=inl")
+
+	cfg := Load()
+	if got := cfg.IDPrefixes["app_id"]; len(got) != 1 || got[0] != "app" {
+		t.Fatalf("expected app_id prefixes from the config file, got %v", cfg.IDPrefixes)
+	}
+	if got := cfg.IDPrefixes["install_id"]; len(got) != 1 || got[0] != "inl" {
+		t.Fatalf("expected NUON_API_ID_PREFIXES to replace install_id prefixes, got %v", cfg.IDPrefixes)
+	}
+}
+
+func TestLoadReadsAnyParamFromEnv(t *testing.T) {
+	t.Setenv("NUON_WORKFLOW_ID", "wf_123")
+
+	cfg := Load()
+	if !cfg.ParamsFromEnv || EnvParam("workflow_id") != "wf_123" {
+		t.Fatalf("expected workflow_id from NUON_WORKFLOW_ID, got %q", EnvParam("workflow_id"))
+	}
+	if ParamEnvVar("workflow_id") != "NUON_WORKFLOW_ID" {
+		t.Fatalf("unexpected env var name %q", ParamEnvVar("workflow_id"))
+	}
+}
+
+func TestEnvParamSkipsSettings(t *testing.T) {
+	t.Setenv("NUON_API_TOKEN", "secret-token")
+	t.Setenv("NUON_DEBUG", "true")
+	t.Setenv("NUON_EXT_DIR", "/tmp/ext")
+
+	for _, name := range []string{"api_token", "debug", "ext_dir"} {
+		if v := EnvParam(name); v != "" {
+			t.Fatalf("expected no placeholder value for {%s}, got %q", name, v)
+		}
+	}
+}
@@ -5,10 +5,18 @@ import (
 	"os"
 )
 
-var enabled bool
+var (
+	enabled bool
+	tracing bool
+)
 
 func init() {
-	enabled = os.Getenv("NUON_DEBUG") == "true"
+	switch os.Getenv("NUON_DEBUG") {
+	case "true":
+		enabled = true
+	case "trace":
+		enabled, tracing = true, true
+	}
 }
 
 // Enabled returns whether debug logging is active.
@@ -16,6 +24,16 @@ func Enabled() bool {
 	return enabled
 }
 
+// Tracing returns whether wire-level tracing is active (NUON_DEBUG=trace or --trace).
+func Tracing() bool {
+	return tracing
+}
+
+// EnableTrace turns on tracing and debug logging for the rest of the process.
+func EnableTrace() {
+	enabled, tracing = true, true
+}
+
 // Log prints a debug message to stderr if NUON_DEBUG=true.
 func Log(format string, args ...any) {
 	if !enabled {
@@ -23,3 +41,11 @@ func Log(format string, args ...any) {
 	}
 	fmt.Fprintf(os.Stderr, "[debug] "+format+"\n", args...)
 }
+
+// Trace prints a trace message to stderr if tracing is active.
+func Trace(format string, args ...any) {
+	if !tracing {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "[trace] "+format+"\n", args...)
+}
@@ -1,6 +1,7 @@
 package dispatch
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -17,13 +18,42 @@ type Request struct {
 	Path    string // resolved path with concrete param values
 	Method  string
 	Payload string // raw JSON body (empty for GET/DELETE)
+
+	Params []resolve.Param // path parameter values and where they came from
+}
+
+// ClientRequest converts the resolved request into a client request, asking
+// for the content types the route produces.
+func (r *Request) ClientRequest(query []client.QueryParam) *client.Request {
+	return &client.Request{
+		Method:  r.Method,
+		Path:    r.Path,
+		Payload: r.Payload,
+		Query:   query,
+		Accept:  r.Route.Accept(),
+	}
 }
 
 // Resolve takes user input (path, optional payload, optional method override)
 // and matches it against the spec to produce an executable Request.
 // If the path contains {param} placeholders, they are resolved via env vars
 // or interactive selection.
-func Resolve(api *spec.API, inputPath, payload, methodOverride string, cfg *config.Config, c *client.Client) (*Request, error) {
+func Resolve(ctx context.Context, api *spec.API, inputPath, payload, methodOverride string, cfg *config.Config, c *client.Client) (*Request, error) {
+	reqs, err := resolveRequests(ctx, api, inputPath, payload, methodOverride, cfg, c, false)
+	if err != nil {
+		return nil, err
+	}
+	return reqs[0], nil
+}
+
+// ResolveAll is Resolve for --multi: with cfg.Multi, the last placeholder
+// left to the selector may take several values, and one Request is returned
+// per value. Without it, ResolveAll returns the single Request of Resolve.
+func ResolveAll(ctx context.Context, api *spec.API, inputPath, payload, methodOverride string, cfg *config.Config, c *client.Client) ([]*Request, error) {
+	return resolveRequests(ctx, api, inputPath, payload, methodOverride, cfg, c, cfg.Multi)
+}
+
+func resolveRequests(ctx context.Context, api *spec.API, inputPath, payload, methodOverride string, cfg *config.Config, c *client.Client, multi bool) ([]*Request, error) {
 	// First, look up the route using the raw input (may contain {param} templates)
 	routes := api.Lookup(inputPath)
 	if len(routes) == 0 {
@@ -56,26 +86,38 @@ func Resolve(api *spec.API, inputPath, payload, methodOverride string, cfg *conf
 	debug.Log("dispatch: %s %s (%s)", method, inputPath, matched.OperationID)
 
 	// Resolve path parameters.
-	// If the input path still contains {param} placeholders, resolve them
-	// via env vars or interactive selection. Otherwise use the input as-is.
-	resolvedPath := inputPath
-	if strings.Contains(inputPath, "{") {
-		pathToResolve := mergeTemplateWithInput(matched.Path, inputPath)
-		debug.Log("dispatch: resolving path params in %s", pathToResolve)
-		var err error
-		resolvedPath, err = resolve.PathParams(pathToResolve, cfg, c)
-		if err != nil {
-			return nil, err
-		}
-		debug.Log("dispatch: resolved to %s", resolvedPath)
+	// Placeholders left in the input are resolved via env vars or
+	// interactive selection; concrete segments that name a resource instead
+	// of giving its ID are looked up.
+	pathToResolve := mergeTemplateWithInput(matched.Path, inputPath)
+	debug.Log("dispatch: resolving path params in %s", pathToResolve)
+	var err error
+	var expanded []resolve.Expanded
+	if multi {
+		expanded, err = resolve.ExpandPathParams(ctx, api, matched.Path, pathToResolve, cfg, c)
+	} else {
+		var e resolve.Expanded
+		e.Path, e.Params, err = resolve.PathParams(ctx, api, matched.Path, pathToResolve, cfg, c)
+		expanded = []resolve.Expanded{e}
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return &Request{
-		Route:   *matched,
-		Path:    resolvedPath,
-		Method:  method,
-		Payload: payload,
-	}, nil
+	reqs := make([]*Request, len(expanded))
+	for i, e := range expanded {
+		if e.Path != inputPath {
+			debug.Log("dispatch: resolved to %s", e.Path)
+		}
+		reqs[i] = &Request{
+			Route:   *matched,
+			Path:    e.Path,
+			Method:  method,
+			Payload: payload,
+			Params:  e.Params,
+		}
+	}
+	return reqs, nil
 }
 
 // mergeTemplateWithInput preserves concrete path segments from user input while
@@ -1,6 +1,7 @@
 package dispatch
 
 import (
+	"context"
 	"testing"
 
 	"github.com/nuonco/nuon-ext-api/internal/config"
@@ -15,7 +16,7 @@ func TestResolvePreservesConcreteSegmentsInMixedPath(t *testing.T) {
 	cfg := &config.Config{InstallID: "ins_123"}
 	inputPath := "/v1/installs/{install_id}/actions/iawag6pbgfzvlkyqdiy2a1xw6j"
 
-	req, err := Resolve(api, inputPath, "", "", cfg, nil)
+	req, err := Resolve(context.Background(), api, inputPath, "", "", cfg, nil)
 	if err != nil {
 		t.Fatalf("Resolve() returned error: %v", err)
 	}
@@ -34,7 +35,7 @@ func TestResolveUsesTemplateParamNamesForUnresolvedSegments(t *testing.T) {
 	cfg := &config.Config{InstallID: "ins_123"}
 	inputPath := "/v1/installs/{foo}/actions/iawag6pbgfzvlkyqdiy2a1xw6j"
 
-	req, err := Resolve(api, inputPath, "", "", cfg, nil)
+	req, err := Resolve(context.Background(), api, inputPath, "", "", cfg, nil)
 	if err != nil {
 		t.Fatalf("Resolve() returned error: %v", err)
 	}
@@ -0,0 +1,57 @@
+package dispatch
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nuonco/nuon-ext-api/internal/client"
+)
+
+// DefaultConcurrency is how many fan-out requests are in flight at once
+// unless --concurrency says otherwise.
+const DefaultConcurrency = 4
+
+// Outcome is the result of one request of a fan-out.
+type Outcome struct {
+	Index    int // position of the request in the fan-out
+	Request  *Request
+	Response *client.Response
+	Err      error
+}
+
+// FanOut sends reqs with at most concurrency in flight and calls fn with each
+// outcome as it completes. fn runs on the calling goroutine, so it need not
+// be safe for concurrent use. Requests not yet started when ctx is cancelled
+// fail with ctx's error.
+func FanOut(ctx context.Context, c *client.Client, reqs []*Request, query []client.QueryParam, concurrency int, fn func(Outcome)) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	outcomes := make(chan Outcome)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	go func() {
+		for i, req := range reqs {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				outcomes <- Outcome{Index: i, Request: req, Err: ctx.Err()}
+				continue
+			}
+			wg.Add(1)
+			go func(i int, req *Request) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				resp, err := c.Send(ctx, req.ClientRequest(query))
+				outcomes <- Outcome{Index: i, Request: req, Response: resp, Err: err}
+			}(i, req)
+		}
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	for o := range outcomes {
+		fn(o)
+	}
+}
@@ -0,0 +1,58 @@
+package dispatch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nuonco/nuon-ext-api/internal/client"
+	"github.com/nuonco/nuon-ext-api/internal/config"
+)
+
+func TestFanOutBoundsConcurrency(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		if r.URL.Path == "/v1/installs/ins_3" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, err := client.New(&config.Config{APIURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reqs []*Request
+	for _, id := range []string{"ins_1", "ins_2", "ins_3", "ins_4", "ins_5"} {
+		reqs = append(reqs, &Request{Method: "GET", Path: "/v1/installs/" + id})
+	}
+
+	seen := make(map[int]int)
+	FanOut(context.Background(), c, reqs, nil, 2, func(o Outcome) {
+		if o.Err != nil {
+			t.Errorf("request %d failed: %v", o.Index, o.Err)
+			return
+		}
+		seen[o.Index] = o.Response.StatusCode
+	})
+
+	if len(seen) != len(reqs) || seen[2] != http.StatusNotFound || seen[0] != http.StatusOK {
+		t.Fatalf("expected an outcome per request, got %v", seen)
+	}
+	if p := peak.Load(); p > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", p)
+	}
+}
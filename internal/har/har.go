@@ -0,0 +1,314 @@
+package har
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/nuonco/nuon-ext-api/internal/atomicfile"
+	"github.com/nuonco/nuon-ext-api/internal/client/timing"
+	"github.com/nuonco/nuon-ext-api/internal/httpbody"
+	"github.com/nuonco/nuon-ext-api/internal/redact"
+)
+
+// CreatorVersion is reported as log.creator.version. It is set to the build
+// version at startup.
+var CreatorVersion = "dev"
+
+// Recorder is an http.RoundTripper that collects every exchange in memory
+// and writes them as a HAR 1.2 log on Close. Credentials are redacted.
+type Recorder struct {
+	Path string
+	Next http.RoundTripper
+
+	mu      sync.Mutex
+	entries []Entry
+}
+
+// NewRecorder wraps next (http.DefaultTransport if nil) and writes the log
+// to path when closed.
+func NewRecorder(path string, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{Path: path, Next: next}
+}
+
+// Log is the top-level HAR document.
+type Log struct {
+	Log struct {
+		Version string  `json:"version"`
+		Creator Creator `json:"creator"`
+		Entries []Entry `json:"entries"`
+	} `json:"log"`
+}
+
+// Creator identifies the tool that wrote the log.
+type Creator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Entry is one request/response exchange.
+type Entry struct {
+	StartedDateTime string   `json:"startedDateTime"`
+	Time            float64  `json:"time"`
+	Request         Request  `json:"request"`
+	Response        Response `json:"response"`
+	Cache           struct{} `json:"cache"`
+	Timings         Timings  `json:"timings"`
+	ServerIPAddress string   `json:"serverIPAddress,omitempty"`
+	Comment         string   `json:"comment,omitempty"`
+}
+
+// Request is the request side of an Entry.
+type Request struct {
+	Method      string    `json:"method"`
+	URL         string    `json:"url"`
+	HTTPVersion string    `json:"httpVersion"`
+	Cookies     []NV      `json:"cookies"`
+	Headers     []NV      `json:"headers"`
+	QueryString []NV      `json:"queryString"`
+	PostData    *PostData `json:"postData,omitempty"`
+	HeadersSize int       `json:"headersSize"`
+	BodySize    int       `json:"bodySize"`
+}
+
+// Response is the response side of an Entry. Failed requests have status 0.
+type Response struct {
+	Status      int     `json:"status"`
+	StatusText  string  `json:"statusText"`
+	HTTPVersion string  `json:"httpVersion"`
+	Cookies     []NV    `json:"cookies"`
+	Headers     []NV    `json:"headers"`
+	Content     Content `json:"content"`
+	RedirectURL string  `json:"redirectURL"`
+	HeadersSize int     `json:"headersSize"`
+	BodySize    int     `json:"bodySize"`
+}
+
+// NV is a HAR name/value pair (headers, query string, cookies).
+type NV struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// PostData holds a request body.
+type PostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+// Content holds a response body; binary bodies are base64 encoded.
+type Content struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// Timings are in milliseconds; -1 marks phases that did not happen.
+type Timings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	SSL     float64 `json:"ssl"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// RoundTrip performs the request and adds it to the log. Requests that fail
+// before a response arrives are logged with status 0 and the error as comment.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := httpbody.Drain(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("har: reading request body: %w", err)
+	}
+
+	timer := timing.Start()
+	req = req.WithContext(timer.WithContext(req.Context()))
+
+	resp, err := r.Next.RoundTrip(req)
+	if err != nil {
+		timer.Stop()
+		e := newEntry(req, reqBody, timer)
+		e.Response = Response{Cookies: []NV{}, Headers: []NV{}, HeadersSize: -1, BodySize: -1}
+		e.Comment = err.Error()
+		r.add(e)
+		return nil, err
+	}
+
+	respBody, err := httpbody.Drain(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("har: reading response body: %w", err)
+	}
+	timer.Stop()
+
+	e := newEntry(req, reqBody, timer)
+	e.Response = newResponse(resp, respBody)
+	r.add(e)
+	return resp, nil
+}
+
+func (r *Recorder) add(e Entry) {
+	r.mu.Lock()
+	r.entries = append(r.entries, e)
+	r.mu.Unlock()
+}
+
+// Close writes the collected entries to Path.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var doc Log
+	doc.Log.Version = "1.2"
+	doc.Log.Creator = Creator{Name: "nuon-ext-api", Version: CreatorVersion}
+	doc.Log.Entries = r.entries
+	if doc.Log.Entries == nil {
+		doc.Log.Entries = []Entry{}
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("har: encoding log: %w", err)
+	}
+	if err := atomicfile.Write(r.Path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("har: %w", err)
+	}
+	return nil
+}
+
+func newEntry(req *http.Request, body []byte, t *timing.Timer) Entry {
+	u := *req.URL
+	query := redact.Query(u.Query())
+	u.RawQuery = query.Encode()
+
+	hreq := Request{
+		Method:      req.Method,
+		URL:         u.String(),
+		HTTPVersion: "HTTP/1.1",
+		Cookies:     []NV{},
+		Headers:     headers(req.Header),
+		QueryString: []NV{},
+		HeadersSize: -1,
+		BodySize:    len(body),
+	}
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range query[k] {
+			hreq.QueryString = append(hreq.QueryString, NV{Name: k, Value: v})
+		}
+	}
+	if len(body) > 0 {
+		hreq.PostData = &PostData{MimeType: req.Header.Get("Content-Type"), Text: string(redact.JSON(body))}
+	}
+
+	return Entry{
+		StartedDateTime: t.Start.Format(time.RFC3339Nano),
+		Time:            ms(t.End.Sub(t.Start)),
+		Request:         hreq,
+		Timings:         timings(t),
+		ServerIPAddress: t.RemoteIP(),
+	}
+}
+
+func newResponse(resp *http.Response, body []byte) Response {
+	content := Content{Size: len(body), MimeType: resp.Header.Get("Content-Type")}
+	switch {
+	case len(body) == 0:
+	case utf8.Valid(body):
+		content.Text = string(redact.JSON(body))
+	default:
+		content.Text = base64.StdEncoding.EncodeToString(body)
+		content.Encoding = "base64"
+	}
+
+	return Response{
+		Status:      resp.StatusCode,
+		StatusText:  strings.TrimSpace(strings.TrimPrefix(resp.Status, fmt.Sprint(resp.StatusCode))),
+		HTTPVersion: resp.Proto,
+		Cookies:     []NV{},
+		Headers:     headers(resp.Header),
+		Content:     content,
+		RedirectURL: resp.Header.Get("Location"),
+		HeadersSize: -1,
+		BodySize:    len(body),
+	}
+}
+
+// headers flattens h into sorted name/value pairs with credentials redacted.
+func headers(h http.Header) []NV {
+	h = redact.Header(h)
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := []NV{}
+	for _, name := range names {
+		for _, v := range h[name] {
+			out = append(out, NV{Name: name, Value: v})
+		}
+	}
+	return out
+}
+
+// timings maps the trace onto HAR phases. Responses that never reached the
+// network (cache hits, replayed cassettes) are reported entirely as wait.
+func timings(p *timing.Timer) Timings {
+	t := Timings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1}
+	if p.GotConn.IsZero() || p.FirstByte.IsZero() {
+		t.Wait = ms(p.End.Sub(p.Start))
+		return t
+	}
+
+	t.DNS = span(p.DNSStart, p.DNSDone)
+	t.Connect = span(p.ConnectStart, p.TLSDone)
+	if p.TLSDone.IsZero() {
+		t.Connect = span(p.ConnectStart, p.ConnectDone)
+	}
+	t.SSL = span(p.TLSStart, p.TLSDone)
+
+	sent := p.WroteRequest
+	if sent.IsZero() {
+		sent = p.GotConn
+	}
+	t.Blocked = ms(p.GotConn.Sub(p.Start))
+	if t.DNS > 0 {
+		t.Blocked -= t.DNS
+	}
+	if t.Connect > 0 {
+		t.Blocked -= t.Connect
+	}
+	if t.Blocked < 0 {
+		t.Blocked = 0
+	}
+	t.Send = ms(sent.Sub(p.GotConn))
+	t.Wait = ms(p.FirstByte.Sub(sent))
+	t.Receive = ms(p.End.Sub(p.FirstByte))
+	return t
+}
+
+func span(start, done time.Time) float64 {
+	if start.IsZero() || done.IsZero() {
+		return -1
+	}
+	return ms(done.Sub(start))
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
@@ -0,0 +1,64 @@
+package har
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorderWritesRedactedHAR(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"app_1","api_token":"server-secret"}`))
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "session.har")
+	rec := NewRecorder(path, nil)
+	c := &http.Client{Transport: rec}
+
+	req, _ := http.NewRequest("POST", srv.URL+"/v1/apps?limit=5", strings.NewReader(`{"name":"web","password":"hunter2"}`))
+	req.Header.Set("Authorization", "Bearer secret-token")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if err := rec.Close(); err != nil {
+		t.Fatalf("Close() returned error: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"secret-token", "hunter2", "server-secret"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("expected %q to be redacted from the HAR file", secret)
+		}
+	}
+
+	var doc Log
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("invalid HAR JSON: %v", err)
+	}
+	if doc.Log.Version != "1.2" || len(doc.Log.Entries) != 1 {
+		t.Fatalf("expected a HAR 1.2 log with 1 entry, got version %q with %d entries", doc.Log.Version, len(doc.Log.Entries))
+	}
+	e := doc.Log.Entries[0]
+	if e.Request.Method != "POST" || e.Response.Status != 201 || e.Response.StatusText != "Created" {
+		t.Fatalf("unexpected entry: %s -> %d %q", e.Request.Method, e.Response.Status, e.Response.StatusText)
+	}
+	if len(e.Request.QueryString) != 1 || e.Request.QueryString[0] != (NV{Name: "limit", Value: "5"}) {
+		t.Fatalf("unexpected query string: %+v", e.Request.QueryString)
+	}
+	if e.Timings.Wait < 0 || e.Timings.Connect < 0 {
+		t.Fatalf("expected connect and wait timings, got %+v", e.Timings)
+	}
+}
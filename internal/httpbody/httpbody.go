@@ -0,0 +1,23 @@
+// Package httpbody reads request and response bodies that must still be
+// forwarded afterwards, for the transports that record exchanges.
+package httpbody
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// Drain reads and replaces *body so it can be both recorded and forwarded.
+func Drain(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
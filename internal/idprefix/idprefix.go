@@ -0,0 +1,327 @@
+package idprefix
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/nuonco/nuon-ext-api/internal/atomicfile"
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+// nuonIDLength is the length of a Nuon ID, whose first three characters name
+// its type (e.g. "ins" for installs).
+const nuonIDLength = 26
+
+// Of returns the type prefix of id: the part before the first "_" for IDs
+// like ins_123, or the first three characters of a Nuon ID. It returns "" for
+// values that carry no recognisable prefix, such as names.
+func Of(id string) string {
+	if prefix, _, ok := strings.Cut(id, "_"); ok && prefix != "" && isLowerAlnum(prefix) {
+		return prefix
+	}
+	if len(id) == nuonIDLength && isLowerAlnum(id) {
+		return id[:3]
+	}
+	return ""
+}
+
+func isLowerAlnum(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// Store maps path parameter names to the ID prefixes known for them, from
+// config and from IDs seen in responses. Learned prefixes persist in a JSON
+// file; a parameter with configured prefixes ignores learned ones.
+type Store struct {
+	path       string
+	configured map[string][]string
+	learned    map[string][]string
+	dirty      bool
+}
+
+// Load reads the learned prefixes from path, if it exists.
+func Load(path string, configured map[string][]string) *Store {
+	s := &Store{path: path, configured: configured, learned: make(map[string][]string)}
+	if data, err := os.ReadFile(path); err == nil {
+		json.Unmarshal(data, &s.learned)
+	}
+	if s.learned == nil {
+		s.learned = make(map[string][]string)
+	}
+	return s
+}
+
+// Prefixes returns the known prefixes for param.
+func (s *Store) Prefixes(param string) []string {
+	if p := s.configured[param]; len(p) > 0 {
+		return p
+	}
+	return s.learned[param]
+}
+
+// Owners returns the params other than except whose IDs have prefix.
+func (s *Store) Owners(prefix, except string) []string {
+	seen := make(map[string]bool)
+	for _, m := range []map[string][]string{s.configured, s.learned} {
+		for param := range m {
+			if param != except && contains(s.Prefixes(param), prefix) {
+				seen[param] = true
+			}
+		}
+	}
+	owners := make([]string, 0, len(seen))
+	for param := range seen {
+		owners = append(owners, param)
+	}
+	sort.Strings(owners)
+	return owners
+}
+
+// Learn records id's prefix for param.
+func (s *Store) Learn(param, id string) {
+	prefix := Of(id)
+	if prefix == "" || contains(s.learned[param], prefix) {
+		return
+	}
+	s.learned[param] = append(s.learned[param], prefix)
+	s.dirty = true
+}
+
+// LearnBody records the prefixes of IDs in a JSON response: fields named
+// after a path parameter (app_id), objects named after one ("app": {"id"}),
+// and the top-level items' "id" as idParam when it is set. params is the set
+// of path parameter names in the spec.
+func (s *Store) LearnBody(body []byte, params map[string]bool, idParam string) {
+	var v any
+	if json.Unmarshal(body, &v) != nil {
+		return
+	}
+
+	top := []any{v}
+	if items, ok := v.([]any); ok {
+		top = items
+	}
+	if idParam != "" {
+		for _, item := range top {
+			if obj, ok := item.(map[string]any); ok {
+				// An item carrying idParam itself wraps that resource (an
+				// install component's id is not a component_id).
+				if _, wraps := obj[idParam]; wraps {
+					continue
+				}
+				if id, ok := obj["id"].(string); ok {
+					s.Learn(idParam, id)
+				}
+			}
+		}
+	}
+	s.walk(v, params)
+}
+
+func (s *Store) walk(v any, params map[string]bool) {
+	switch v := v.(type) {
+	case []any:
+		for _, item := range v {
+			s.walk(item, params)
+		}
+	case map[string]any:
+		for key, val := range v {
+			if id, ok := val.(string); ok && params[key] {
+				s.Learn(key, id)
+			}
+			if obj, ok := val.(map[string]any); ok && params[key+"_id"] {
+				if id, ok := obj["id"].(string); ok {
+					s.Learn(key+"_id", id)
+				}
+			}
+			s.walk(val, params)
+		}
+	}
+}
+
+// Save writes the learned prefixes if any were added.
+func (s *Store) Save() error {
+	if !s.dirty {
+		return nil
+	}
+	data, err := json.Marshal(s.learned)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+	if err := atomicfile.Write(s.path, data, 0o600); err != nil {
+		return err
+	}
+	s.dirty = false
+	return nil
+}
+
+// Mismatch is a path parameter given an ID whose prefix belongs to other
+// parameters.
+type Mismatch struct {
+	Param       string   // placeholder the value was given for, e.g. app_id
+	Value       string   // the ID given
+	Owners      []string // params the ID's prefix belongs to, e.g. install_id
+	Suggestions []string // argument orders or routes that would fit
+}
+
+func (m Mismatch) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "{%s} = %s looks like %s (prefix %q)", m.Param, m.Value, orList(m.Owners), Of(m.Value))
+	for _, s := range m.Suggestions {
+		b.WriteString("\n  " + s)
+	}
+	return b.String()
+}
+
+// Check returns the path parameters of route whose values carry a prefix
+// known for other parameters but not for their own. Parameters without known
+// prefixes are not checked.
+func (s *Store) Check(api *spec.API, route spec.Route, values map[string]string) []Mismatch {
+	names := placeholders(route.Path)
+
+	var mismatches []Mismatch
+	for _, name := range names {
+		value := values[name]
+		prefix := Of(value)
+		known := s.Prefixes(name)
+		if prefix == "" || len(known) == 0 || contains(known, prefix) {
+			continue
+		}
+		owners := s.Owners(prefix, name)
+		if len(owners) == 0 {
+			continue
+		}
+
+		m := Mismatch{Param: name, Value: value, Owners: owners}
+		for _, other := range names {
+			if contains(owners, other) && contains(known, Of(values[other])) {
+				m.Suggestions = append(m.Suggestions, fmt.Sprintf("the values for {%s} and {%s} look swapped", name, other))
+			}
+		}
+		if len(m.Suggestions) == 0 && api != nil {
+			for _, alt := range alternatives(api, route, name, owners) {
+				m.Suggestions = append(m.Suggestions, "did you mean "+alt+"?")
+			}
+		}
+		mismatches = append(mismatches, m)
+	}
+	return mismatches
+}
+
+// maxAlternatives caps the routes suggested for one mismatch.
+const maxAlternatives = 3
+
+// alternatives returns routes with route's method that take one of owners
+// where route takes param, e.g. /v1/installs/{install_id}/components for
+// /v1/apps/{app_id}/components. The segment before the placeholder may differ.
+func alternatives(api *spec.API, route spec.Route, param string, owners []string) []string {
+	segments := strings.Split(route.Path, "/")
+	at := -1
+	for i, seg := range segments {
+		if seg == "{"+param+"}" {
+			at = i
+		}
+	}
+
+	var found []string
+	for _, r := range api.Routes {
+		if r.Method != route.Method || r.Path == route.Path || r.Deprecated {
+			continue
+		}
+		other := strings.Split(r.Path, "/")
+		if len(other) != len(segments) {
+			continue
+		}
+		ok := false
+		for _, owner := range owners {
+			if other[at] == "{"+owner+"}" {
+				ok = true
+			}
+		}
+		for i := range segments {
+			if i != at && i != at-1 && other[i] != segments[i] {
+				ok = false
+			}
+		}
+		if ok {
+			found = append(found, r.DisplayName())
+		}
+		if len(found) == maxAlternatives {
+			break
+		}
+	}
+	return found
+}
+
+// ParamNames returns the set of path parameter names in the spec.
+func ParamNames(api *spec.API) map[string]bool {
+	names := make(map[string]bool)
+	for _, r := range api.Routes {
+		for _, name := range placeholders(r.Path) {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// IDParam returns the parameter that the "id" of route's response items
+// fills: the last placeholder of an item route (/v1/apps/{app_id}), or the
+// placeholder of the item route under a collection (/v1/apps). It returns ""
+// when there is neither.
+func IDParam(api *spec.API, route spec.Route) string {
+	if names := placeholders(route.Path); len(names) > 0 && strings.HasSuffix(route.Path, "/{"+names[len(names)-1]+"}") {
+		return names[len(names)-1]
+	}
+	for _, r := range api.Routes {
+		if r.Method != "GET" || !strings.HasPrefix(r.Path, route.Path+"/{") {
+			continue
+		}
+		if rest := strings.TrimPrefix(r.Path, route.Path+"/"); !strings.Contains(rest, "/") {
+			return strings.Trim(rest, "{}")
+		}
+	}
+	return ""
+}
+
+// placeholders returns the parameter names in a path template, in order.
+func placeholders(path string) []string {
+	var names []string
+	for _, seg := range strings.Split(path, "/") {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			names = append(names, seg[1:len(seg)-1])
+		}
+	}
+	return names
+}
+
+func orList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = "{" + n + "}"
+	}
+	if len(quoted) == 1 {
+		return "an ID for " + quoted[0]
+	}
+	return "an ID for " + strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
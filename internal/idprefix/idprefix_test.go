@@ -0,0 +1,69 @@
+package idprefix
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+func TestOf(t *testing.T) {
+	cases := map[string]string{
+		"ins_123":                    "ins",
+		"insabcdefghijklmnopqrstuvw": "ins",
+		"my-app":                     "",
+		"Prod_US":                    "",
+	}
+	for id, want := range cases {
+		if got := Of(id); got != want {
+			t.Fatalf("Of(%q): expected %q, got %q", id, want, got)
+		}
+	}
+}
+
+func TestLearnBodyAndPersist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "id-prefixes.json")
+	s := Load(path, nil)
+	params := map[string]bool{"app_id": true, "install_id": true, "component_id": true}
+
+	s.LearnBody([]byte(`[{"id":"ins_1","app_id":"app_1","install_components":[{"id":"inc_1","component_id":"cmp_1","component":{"id":"cmp_1"}}]}]`), params, "install_id")
+	if err := s.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	s = Load(path, map[string][]string{"app_id": {"ap"}})
+	want := map[string]string{"install_id": "ins", "component_id": "cmp", "app_id": "ap"}
+	for param, prefix := range want {
+		if got := s.Prefixes(param); len(got) != 1 || got[0] != prefix {
+			t.Fatalf("expected %s prefix %q, got %v", param, prefix, got)
+		}
+	}
+}
+
+func TestCheckSuggestsSwapAndRoutes(t *testing.T) {
+	api, err := spec.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := Load(filepath.Join(t.TempDir(), "p.json"), map[string][]string{"app_id": {"app"}, "install_id": {"ins"}, "component_id": {"cmp"}})
+
+	route := *api.LookupByMethod("/v1/apps/{app_id}/components", "GET")
+	got := s.Check(api, route, map[string]string{"app_id": "ins_1"})
+	if len(got) != 1 || got[0].Owners[0] != "install_id" {
+		t.Fatalf("expected install_id to own the prefix, got %+v", got)
+	}
+	if !strings.Contains(got[0].String(), "GET /v1/installs/{install_id}/components") {
+		t.Fatalf("expected the install route to be suggested, got:\n%s", got[0])
+	}
+
+	route = *api.LookupByMethod("/v1/installs/{install_id}/components/{component_id}", "GET")
+	got = s.Check(api, route, map[string]string{"install_id": "cmp_1", "component_id": "ins_1"})
+	if len(got) != 2 || !strings.Contains(got[0].String(), "look swapped") {
+		t.Fatalf("expected swapped arguments, got %+v", got)
+	}
+
+	if got := s.Check(api, route, map[string]string{"install_id": "ins_1", "component_id": "xyz_1"}); len(got) != 0 {
+		t.Fatalf("expected unknown prefixes to pass, got %+v", got)
+	}
+}
@@ -0,0 +1,133 @@
+package mock
+
+import (
+	"strings"
+
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+// maxDepth bounds how many levels of nested objects and arrays are generated.
+const maxDepth = 4
+
+// exampleTime is used for every timestamp so responses are deterministic.
+const exampleTime = "2026-01-01T00:00:00Z"
+
+// example builds a deterministic, schema-valid value for s. Recursive and
+// overly deep definitions are cut off: such objects become null and such
+// arrays become empty.
+func example(api *spec.API, s *spec.Schema) any {
+	g := generator{api: api, expanding: make(map[string]bool)}
+	return g.value(s, "", 0)
+}
+
+type generator struct {
+	api       *spec.API
+	expanding map[string]bool
+}
+
+func (g generator) value(s *spec.Schema, name string, depth int) any {
+	if s == nil {
+		return nil
+	}
+
+	if s.Ref != "" {
+		if g.expanding[s.Ref] {
+			return nil
+		}
+		def := g.api.Definition(s.Ref)
+		if def == nil {
+			return map[string]any{}
+		}
+		g.expanding[s.Ref] = true
+		defer delete(g.expanding, s.Ref)
+		return g.value(def, name, depth)
+	}
+
+	if len(s.AllOf) > 0 {
+		merged := map[string]any{}
+		for _, part := range s.AllOf {
+			if obj, ok := g.value(part, name, depth).(map[string]any); ok {
+				for k, v := range obj {
+					merged[k] = v
+				}
+			}
+		}
+		return merged
+	}
+
+	if len(s.Enum) > 0 {
+		return s.Enum[0]
+	}
+
+	switch s.Type {
+	case "string":
+		return exampleString(s, name)
+	case "integer":
+		if s.Minimum != nil {
+			return int(*s.Minimum)
+		}
+		return 0
+	case "number":
+		if s.Minimum != nil {
+			return *s.Minimum
+		}
+		return 0.0
+	case "boolean":
+		return false
+	case "file":
+		return "mock file contents\n"
+	case "array":
+		if depth >= maxDepth {
+			return []any{}
+		}
+		item := g.value(s.Items, name, depth+1)
+		if item == nil {
+			return []any{}
+		}
+		return []any{item}
+	case "object", "":
+		if len(s.Properties) == 0 {
+			return map[string]any{}
+		}
+		if depth >= maxDepth {
+			return nil
+		}
+		obj := make(map[string]any, len(s.Properties))
+		for propName, prop := range s.Properties {
+			obj[propName] = g.value(prop, propName, depth+1)
+		}
+		return obj
+	}
+	return nil
+}
+
+// exampleString picks a plausible string from the format or property name.
+func exampleString(s *spec.Schema, name string) string {
+	switch {
+	case s.Format == "date-time", strings.HasSuffix(name, "_at"):
+		return exampleTime
+	case name == "id" || strings.HasSuffix(name, "_id"):
+		return exampleID(strings.TrimSuffix(name, "_id"))
+	case s.Format == "uri", strings.HasSuffix(name, "_url"):
+		return "https://example.com"
+	case name != "":
+		v := "example-" + strings.ReplaceAll(name, "_", "-")
+		if s.MaxLength != nil && len(v) > *s.MaxLength {
+			v = v[:*s.MaxLength]
+		}
+		return v
+	}
+	return "string"
+}
+
+// exampleID returns a stable 26 character lowercase ID shaped like Nuon's.
+func exampleID(kind string) string {
+	if kind == "" || kind == "id" {
+		kind = "mock"
+	}
+	id := strings.ReplaceAll(kind, "_", "")
+	for len(id) < 26 {
+		id += "0"
+	}
+	return id[:26]
+}
@@ -0,0 +1,242 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+// Options configures the mock API.
+type Options struct {
+	// FixturesDir holds per-operation overrides named <operationId>.json
+	// (e.g., GetApps.json). A fixture replaces the generated response body.
+	FixturesDir string
+}
+
+// Handler serves every route in the spec with generated example responses.
+type Handler struct {
+	api  *spec.API
+	opts Options
+}
+
+// NewHandler returns an http.Handler that mocks the Nuon API described by api.
+func NewHandler(api *spec.API, opts Options) *Handler {
+	return &Handler{api: api, opts: opts}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route, params, allowed := h.match(r.Method, r.URL.Path)
+	if route == nil {
+		if len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s not allowed", r.Method), "allowed: "+strings.Join(allowed, ", "))
+			return
+		}
+		writeError(w, http.StatusNotFound, "not found", fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+		return
+	}
+
+	if route.HasBody {
+		if problems := h.validateBody(route, r.Body); len(problems) > 0 {
+			writeError(w, http.StatusBadRequest, "invalid request body", strings.Join(problems, "; "))
+			return
+		}
+	}
+
+	status := route.SuccessStatus
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	body, err := h.fixture(route.OperationID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "reading fixture", err.Error())
+		return
+	}
+	contentType := "application/json"
+	if len(route.Produces) > 0 {
+		contentType = route.Produces[0]
+	}
+	if body == nil {
+		body, err = h.generate(route, params, contentType)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "generating response", err.Error())
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Nuon-Mock-Operation", route.OperationID)
+	if hasQueryParam(route, "offset") {
+		// Generated lists are a single page.
+		w.Header().Set("X-Nuon-Page-Next", "false")
+	}
+	w.WriteHeader(status)
+	if status != http.StatusNoContent {
+		w.Write(body)
+	}
+}
+
+// match finds the route for method and path, preferring templates with more
+// literal segments ("/v1/installs/current" over "/v1/installs/{install_id}").
+// If the path exists under other methods, they are returned as allowed.
+func (h *Handler) match(method, path string) (*spec.Route, map[string]string, []string) {
+	var best *spec.Route
+	var bestParams map[string]string
+	bestScore := -1
+	allowed := map[string]bool{}
+
+	for i := range h.api.Routes {
+		r := &h.api.Routes[i]
+		ok, params := r.MatchesPath(path)
+		if !ok {
+			continue
+		}
+		if r.Method != method {
+			allowed[r.Method] = true
+			continue
+		}
+		score := strings.Count(r.Path, "/") - len(params)
+		if score > bestScore {
+			best, bestParams, bestScore = r, params, score
+		}
+	}
+
+	if best != nil {
+		return best, bestParams, nil
+	}
+	methods := make([]string, 0, len(allowed))
+	for m := range allowed {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+	return nil, nil, methods
+}
+
+func (h *Handler) validateBody(route *spec.Route, body io.Reader) []string {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return []string{"reading body: " + err.Error()}
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return []string{"body: required"}
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return []string{"body: invalid JSON: " + err.Error()}
+	}
+	return validate(h.api, route.Body, v)
+}
+
+func (h *Handler) fixture(operationID string) ([]byte, error) {
+	if h.opts.FixturesDir == "" || operationID == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(filepath.Join(h.opts.FixturesDir, operationID+".json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// generate renders an example response for route in the given content type.
+// Concrete path parameters are echoed back: the last one becomes the "id" of
+// a top-level object, and any matching top-level field is set to its value.
+func (h *Handler) generate(route *spec.Route, params map[string]string, contentType string) ([]byte, error) {
+	value := example(h.api, route.Response)
+
+	if obj, ok := value.(map[string]any); ok {
+		for name, v := range params {
+			if _, exists := obj[name]; exists {
+				obj[name] = v
+			}
+		}
+		if _, hasID := obj["id"]; hasID && len(route.PathParams) > 0 {
+			last := route.PathParams[len(route.PathParams)-1].Name
+			if v, ok := params[last]; ok && !strings.HasPrefix(v, "{") {
+				obj["id"] = v
+			}
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(contentType, "text/csv"):
+		return renderCSV(value)
+	case contentType == "application/octet-stream":
+		if s, ok := value.(string); ok {
+			return []byte(s), nil
+		}
+		return []byte("mock file contents\n"), nil
+	}
+
+	if value == nil {
+		value = map[string]any{}
+	}
+	return json.MarshalIndent(value, "", "  ")
+}
+
+// renderCSV flattens an array of objects into CSV with sorted scalar columns.
+func renderCSV(value any) ([]byte, error) {
+	rows, _ := value.([]any)
+	var header []string
+	if len(rows) > 0 {
+		if obj, ok := rows[0].(map[string]any); ok {
+			for k, v := range obj {
+				switch v.(type) {
+				case map[string]any, []any:
+					continue
+				}
+				header = append(header, k)
+			}
+			sort.Strings(header)
+		}
+	}
+
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	cw.Write(header)
+	for _, row := range rows {
+		obj, _ := row.(map[string]any)
+		record := make([]string, len(header))
+		for i, k := range header {
+			if obj[k] != nil {
+				record[i] = fmt.Sprint(obj[k])
+			}
+		}
+		cw.Write(record)
+	}
+	cw.Flush()
+	return buf.Bytes(), cw.Error()
+}
+
+// writeError responds in the API's stderr.ErrResponse shape.
+func writeError(w http.ResponseWriter, status int, msg, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{
+		"error":       msg,
+		"description": description,
+		"user_error":  status < 500,
+	})
+}
+
+func hasQueryParam(route *spec.Route, name string) bool {
+	for _, p := range route.QueryParams {
+		if p.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package mock
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+func newTestServer(t *testing.T, opts Options) *httptest.Server {
+	t.Helper()
+	api, err := spec.Parse()
+	if err != nil {
+		t.Fatalf("Parse() returned error: %v", err)
+	}
+	srv := httptest.NewServer(NewHandler(api, opts))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestServeGeneratesSchemaShapedList(t *testing.T) {
+	srv := newTestServer(t, Options{})
+
+	resp, err := http.Get(srv.URL + "/v1/apps")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	var apps []map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&apps); err != nil {
+		t.Fatalf("expected a JSON array: %v", err)
+	}
+	if len(apps) != 1 || apps[0]["id"] == nil || apps[0]["name"] == nil {
+		t.Fatalf("expected one app with id and name, got %v", apps)
+	}
+}
+
+func TestServeEchoesPathParamAsID(t *testing.T) {
+	srv := newTestServer(t, Options{})
+
+	resp, err := http.Get(srv.URL + "/v1/apps/app_123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var app map[string]any
+	json.NewDecoder(resp.Body).Decode(&app)
+	if app["id"] != "app_123" {
+		t.Fatalf("expected id %q, got %v", "app_123", app["id"])
+	}
+}
+
+func TestServeValidatesRequestBody(t *testing.T) {
+	srv := newTestServer(t, Options{})
+
+	resp, err := http.Post(srv.URL+"/v1/apps", "application/json", strings.NewReader(`{"display_name": 5}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", resp.StatusCode)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	for _, want := range []string{"body.name: required", "body.display_name: expected string"} {
+		if !strings.Contains(string(body), want) {
+			t.Fatalf("expected error body to contain %q, got %s", want, body)
+		}
+	}
+
+	resp, err = http.Post(srv.URL+"/v1/apps", "application/json", strings.NewReader(`{"name": "my-app"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status 201 for a valid body, got %d", resp.StatusCode)
+	}
+}
+
+func TestServeUsesFixtureOverride(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "GetApps.json"), []byte(`[{"id":"app_fixture"}]`), 0o644)
+	srv := newTestServer(t, Options{FixturesDir: dir})
+
+	resp, err := http.Get(srv.URL + "/v1/apps")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `[{"id":"app_fixture"}]` {
+		t.Fatalf("expected fixture body, got %s", body)
+	}
+}
+
+func TestServeUnknownRoute(t *testing.T) {
+	srv := newTestServer(t, Options{})
+
+	resp, err := http.Get(srv.URL + "/v1/nope")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", resp.StatusCode)
+	}
+}
+
+func TestEveryRouteGeneratesAResponse(t *testing.T) {
+	api, err := spec.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHandler(api, Options{})
+
+	for _, r := range api.Routes {
+		contentType := "application/json"
+		if len(r.Produces) > 0 {
+			contentType = r.Produces[0]
+		}
+		if _, err := h.generate(&r, nil, contentType); err != nil {
+			t.Errorf("%s: %v", r.DisplayName(), err)
+		}
+	}
+}
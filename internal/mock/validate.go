@@ -0,0 +1,128 @@
+package mock
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+// maxValidateDepth guards against runaway recursion through self-referencing definitions.
+const maxValidateDepth = 64
+
+// validate checks a decoded JSON value against s and returns one message per
+// violation. It covers types, required properties, enums and simple bounds.
+func validate(api *spec.API, s *spec.Schema, v any) []string {
+	var errs []string
+	validateAt(api, s, v, "body", &errs, 0)
+	return errs
+}
+
+func validateAt(api *spec.API, s *spec.Schema, v any, path string, errs *[]string, depth int) {
+	if s == nil || depth > maxValidateDepth {
+		return
+	}
+
+	if s.Ref != "" {
+		validateAt(api, api.Definition(s.Ref), v, path, errs, depth+1)
+		return
+	}
+	for _, part := range s.AllOf {
+		validateAt(api, part, v, path, errs, depth+1)
+	}
+
+	// Nulls are accepted for optional fields; required-ness is checked by the parent.
+	if v == nil {
+		return
+	}
+
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(e any) bool { return fmt.Sprint(e) == fmt.Sprint(v) }) {
+		*errs = append(*errs, fmt.Sprintf("%s: %v is not one of %v", path, v, s.Enum))
+	}
+
+	switch s.Type {
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			*errs = append(*errs, fmt.Sprintf("%s: expected string, got %s", path, jsonType(v)))
+			return
+		}
+		if s.MinLength != nil && len(str) < *s.MinLength {
+			*errs = append(*errs, fmt.Sprintf("%s: shorter than %d characters", path, *s.MinLength))
+		}
+		if s.MaxLength != nil && len(str) > *s.MaxLength {
+			*errs = append(*errs, fmt.Sprintf("%s: longer than %d characters", path, *s.MaxLength))
+		}
+	case "integer", "number":
+		n, ok := v.(float64)
+		if !ok {
+			*errs = append(*errs, fmt.Sprintf("%s: expected %s, got %s", path, s.Type, jsonType(v)))
+			return
+		}
+		if s.Type == "integer" && n != float64(int64(n)) {
+			*errs = append(*errs, fmt.Sprintf("%s: expected integer, got %v", path, n))
+		}
+		if s.Minimum != nil && n < *s.Minimum {
+			*errs = append(*errs, fmt.Sprintf("%s: %v is less than %v", path, n, *s.Minimum))
+		}
+		if s.Maximum != nil && n > *s.Maximum {
+			*errs = append(*errs, fmt.Sprintf("%s: %v is greater than %v", path, n, *s.Maximum))
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			*errs = append(*errs, fmt.Sprintf("%s: expected boolean, got %s", path, jsonType(v)))
+		}
+	case "array":
+		items, ok := v.([]any)
+		if !ok {
+			*errs = append(*errs, fmt.Sprintf("%s: expected array, got %s", path, jsonType(v)))
+			return
+		}
+		if s.MinItems != nil && len(items) < *s.MinItems {
+			*errs = append(*errs, fmt.Sprintf("%s: expected at least %d items", path, *s.MinItems))
+		}
+		for i, item := range items {
+			validateAt(api, s.Items, item, fmt.Sprintf("%s[%d]", path, i), errs, depth+1)
+		}
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			*errs = append(*errs, fmt.Sprintf("%s: expected object, got %s", path, jsonType(v)))
+			return
+		}
+		for _, name := range s.Required {
+			if _, ok := obj[name]; !ok {
+				*errs = append(*errs, fmt.Sprintf("%s.%s: required", path, name))
+			}
+		}
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if prop, ok := s.Properties[k]; ok {
+				validateAt(api, prop, obj[k], path+"."+k, errs, depth+1)
+			} else if s.AdditionalProperties != nil {
+				validateAt(api, s.AdditionalProperties, obj[k], path+"."+k, errs, depth+1)
+			}
+		}
+	}
+}
+
+func jsonType(v any) string {
+	switch v.(type) {
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return "null"
+}
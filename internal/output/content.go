@@ -0,0 +1,124 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"unicode/utf8"
+
+	"github.com/mattn/go-isatty"
+
+	"github.com/nuonco/nuon-ext-api/internal/atomicfile"
+)
+
+// contentKind classifies a response body for rendering.
+type contentKind int
+
+const (
+	kindJSON contentKind = iota
+	kindCSV
+	kindText
+	kindBinary
+)
+
+// classify picks a rendering strategy from the Content-Type header, falling
+// back to sniffing the body for untyped or generic octet-stream responses.
+func classify(contentType string, body []byte) contentKind {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = ""
+	}
+
+	switch {
+	case mediaType == "", mediaType == "application/json", strings.HasSuffix(mediaType, "+json"):
+		if mediaType == "" && !isText(body) {
+			return kindBinary
+		}
+		return kindJSON
+	case mediaType == "text/csv":
+		return kindCSV
+	case strings.HasPrefix(mediaType, "text/"):
+		return kindText
+	case mediaType == "application/octet-stream" && isText(body):
+		return kindText
+	}
+	return kindBinary
+}
+
+// isText reports whether body looks like printable UTF-8 text.
+func isText(body []byte) bool {
+	return utf8.Valid(body) && !bytes.ContainsRune(body, 0)
+}
+
+// isTerminal reports whether f is attached to a terminal.
+func isTerminal(f *os.File) bool {
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
+// printCSVTable renders CSV as aligned columns. Malformed CSV is written as-is.
+func printCSVTable(w io.Writer, data []byte) error {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		_, err := w.Write(data)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for i, record := range records {
+		fmt.Fprintln(tw, strings.Join(record, "\t"))
+		if i == 0 {
+			sep := make([]string, len(record))
+			for j, field := range record {
+				sep[j] = strings.Repeat("-", utf8.RuneCountInString(field))
+			}
+			fmt.Fprintln(tw, strings.Join(sep, "\t"))
+		}
+	}
+	return tw.Flush()
+}
+
+// writeFileAtomic writes data to path so readers never observe a partially
+// written file. An existing file keeps its mode, and a symlink is written
+// through to its target. Devices and pipes (-o /dev/stdout) cannot be
+// replaced and are written in place.
+func writeFileAtomic(path string, data []byte) error {
+	perm := os.FileMode(0o644)
+	target := path
+	switch info, err := os.Stat(path); {
+	case err == nil && !info.Mode().IsRegular():
+		return writeFile(path, data)
+	case err == nil:
+		perm = info.Mode().Perm()
+		if resolved, err := filepath.EvalSymlinks(path); err == nil {
+			target = resolved
+		}
+	case isSymlink(path):
+		// A dangling symlink: create its target rather than replace it.
+		return writeFile(path, data)
+	}
+
+	if err := atomicfile.Write(target, data, perm); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return nil
+}
+
+func writeFile(path string, data []byte) error {
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return nil
+}
+
+func isSymlink(path string) bool {
+	info, err := os.Lstat(path)
+	return err == nil && info.Mode()&os.ModeSymlink != 0
+}
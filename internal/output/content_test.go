@@ -0,0 +1,136 @@
+package output
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		contentType string
+		body        []byte
+		want        contentKind
+	}{
+		{"application/json; charset=utf-8", []byte(`{}`), kindJSON},
+		{"", []byte(`[]`), kindJSON},
+		{"text/csv", []byte("a,b\n1,2\n"), kindCSV},
+		{"text/plain", []byte("hello"), kindText},
+		{"application/octet-stream", []byte("api_url = \"x\"\n"), kindText},
+		{"application/octet-stream", []byte{0x1f, 0x8b, 0x00, 0xff}, kindBinary},
+		{"application/zip", []byte("PK"), kindBinary},
+	}
+
+	for _, tt := range tests {
+		if got := classify(tt.contentType, tt.body); got != tt.want {
+			t.Errorf("classify(%q, %q) = %v, want %v", tt.contentType, tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestPrintCSVTableAlignsColumns(t *testing.T) {
+	var buf bytes.Buffer
+	if err := printCSVTable(&buf, []byte("id,name\nins_1,prod-us\nins_22,dev\n")); err != nil {
+		t.Fatalf("printCSVTable() returned error: %v", err)
+	}
+
+	want := "id      name\n--      ----\nins_1   prod-us\nins_22  dev\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("unexpected table:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestWriteFileAtomicReplacesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeFileAtomic(path, []byte("new")); err != nil {
+		t.Fatalf("writeFileAtomic() returned error: %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "new" {
+		t.Fatalf("expected file contents %q, got %q", "new", got)
+	}
+
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Fatalf("expected temp file to be cleaned up, found %d entries", len(entries))
+	}
+}
+
+func TestWriteFileAtomicKeepsMode(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secret.json")
+	if err := os.WriteFile(path, []byte("old"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeFileAtomic(path, []byte("new")); err != nil {
+		t.Fatalf("writeFileAtomic() returned error: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected mode 0600 to be kept, got %v", info.Mode().Perm())
+	}
+}
+
+func TestWriteFileAtomicWritesThroughSymlink(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target.json")
+	link := filepath.Join(dir, "link.json")
+	if err := os.WriteFile(target, []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("symlinks unsupported: %v", err)
+	}
+
+	if err := writeFileAtomic(link, []byte("new")); err != nil {
+		t.Fatalf("writeFileAtomic() returned error: %v", err)
+	}
+
+	if info, err := os.Lstat(link); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		t.Fatalf("expected %s to stay a symlink, got %v, %v", link, info, err)
+	}
+	got, _ := os.ReadFile(target)
+	if string(got) != "new" {
+		t.Fatalf("expected the target to contain %q, got %q", "new", got)
+	}
+
+	// A dangling symlink creates its target.
+	dangling := filepath.Join(dir, "dangling.json")
+	missing := filepath.Join(dir, "missing.json")
+	if err := os.Symlink(missing, dangling); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeFileAtomic(dangling, []byte("new")); err != nil {
+		t.Fatalf("writeFileAtomic() returned error: %v", err)
+	}
+	if got, _ := os.ReadFile(missing); string(got) != "new" {
+		t.Fatalf("expected the dangling link's target to be created, got %q", got)
+	}
+}
+
+func TestWriteFileAtomicWritesDevicesInPlace(t *testing.T) {
+	for _, path := range []string{os.DevNull, "/dev/stdout"} {
+		if _, err := os.Stat(path); err != nil {
+			t.Logf("skipping %s: %v", path, err)
+			continue
+		}
+		// Nothing is written, so the test output stays clean; replacing the
+		// device would fail regardless of the data.
+		if err := writeFileAtomic(path, nil); err != nil {
+			t.Fatalf("%s: writeFileAtomic() returned error: %v", path, err)
+		}
+	}
+}
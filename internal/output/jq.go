@@ -0,0 +1,75 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/itchyny/gojq"
+)
+
+// JQ is a compiled --jq filter applied to JSON response bodies.
+type JQ struct {
+	code *gojq.Code
+}
+
+// CompileJQ parses and compiles a jq program, so that syntax errors are
+// reported before any request is sent.
+func CompileJQ(expr string) (*JQ, error) {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	return &JQ{code: code}, nil
+}
+
+// Run filters the JSON document data and writes each result to w on its own
+// line: indented unless compact, and strings without quotes with rawStrings
+// (jq -r).
+func (q *JQ) Run(w io.Writer, data []byte, compact, rawStrings bool) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var input any
+	if err := dec.Decode(&input); err != nil {
+		return fmt.Errorf("--jq: response is not JSON: %w", err)
+	}
+
+	iter := q.code.Run(input)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+		if err, ok := v.(error); ok {
+			if err, ok := err.(*gojq.HaltError); ok && err.Value() == nil {
+				return nil
+			}
+			return fmt.Errorf("--jq: %w", err)
+		}
+
+		if s, ok := v.(string); ok && rawStrings {
+			if _, err := fmt.Fprintln(w, s); err != nil {
+				return err
+			}
+			continue
+		}
+		out, err := gojq.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("--jq: %w", err)
+		}
+		if !compact {
+			var buf bytes.Buffer
+			if json.Indent(&buf, out, "", "  ") == nil {
+				out = buf.Bytes()
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%s\n", out); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestJQFiltersAndFormatsResults(t *testing.T) {
+	q, err := CompileJQ(`.[] | select(.status == "active") | .name, {id}`)
+	if err != nil {
+		t.Fatalf("CompileJQ() returned error: %v", err)
+	}
+	body := []byte(`[{"id":"ins_1","name":"prod-us","status":"active"},{"id":"ins_2","name":"dev","status":"error"}]`)
+
+	var buf bytes.Buffer
+	if err := q.Run(&buf, body, false, false); err != nil {
+		t.Fatalf("Run() returned error: %v", err)
+	}
+	if want := "\"prod-us\"\n{\n  \"id\": \"ins_1\"\n}\n"; buf.String() != want {
+		t.Fatalf("unexpected output:\ngot  %q\nwant %q", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := q.Run(&buf, body, true, true); err != nil {
+		t.Fatalf("Run() returned error: %v", err)
+	}
+	if want := "prod-us\n{\"id\":\"ins_1\"}\n"; buf.String() != want {
+		t.Fatalf("expected raw strings and compact JSON, got %q", buf.String())
+	}
+}
+
+func TestJQKeepsLargeIntegers(t *testing.T) {
+	q, _ := CompileJQ(".count")
+
+	var buf bytes.Buffer
+	if err := q.Run(&buf, []byte(`{"count":12345678901234567890}`), true, false); err != nil {
+		t.Fatalf("Run() returned error: %v", err)
+	}
+	if buf.String() != "12345678901234567890\n" {
+		t.Fatalf("expected the integer unchanged, got %q", buf.String())
+	}
+}
+
+func TestJQErrors(t *testing.T) {
+	if _, err := CompileJQ(".[] |"); err == nil || !strings.Contains(err.Error(), "invalid --jq expression") {
+		t.Fatalf("expected a syntax error, got %v", err)
+	}
+
+	q, _ := CompileJQ(".name | ascii_downcase")
+	if err := q.Run(&bytes.Buffer{}, []byte(`{"name":1}`), true, false); err == nil {
+		t.Fatal("expected a runtime error")
+	}
+}
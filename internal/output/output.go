@@ -4,16 +4,79 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/nuonco/nuon-ext-api/internal/client"
 )
 
+// Options controls how a response is written.
+type Options struct {
+	Raw        bool     // print the body as-is instead of pretty-printed JSON
+	Include    bool     // print the status line and headers before the body
+	StatusOnly bool     // print only the status code
+	Headers    []string // print only the values of these response headers
+	OutputFile string   // write the body to this file instead of stdout
+	JQ         *JQ      // filter JSON bodies through this jq program
+	RawStrings bool     // with JQ, print string results without quotes (jq -r)
+}
+
 // Print writes an API response to stdout.
-// If raw is true, prints the body as-is. Otherwise pretty-prints JSON.
-func Print(resp *client.Response, raw bool) error {
+// If opts.Raw is true, prints the body as-is. Otherwise pretty-prints JSON.
+// Error responses are written to stderr and returned as an error.
+func Print(resp *client.Response, opts Options) error {
+	if opts.StatusOnly || len(opts.Headers) > 0 {
+		return printMeta(resp, opts)
+	}
+
 	if resp.StatusCode >= 400 {
-		return printError(resp, raw)
+		if opts.Include {
+			printStatusAndHeaders(os.Stderr, resp)
+		}
+		return printError(resp, opts.Raw)
+	}
+
+	if opts.Include {
+		printStatusAndHeaders(os.Stdout, resp)
+	}
+
+	if opts.JQ != nil {
+		return printJQ(resp, opts)
+	}
+
+	if opts.OutputFile != "" {
+		return writeFileAtomic(opts.OutputFile, resp.Body)
+	}
+
+	return printBody(resp, opts.Raw)
+}
+
+// printBody renders the body according to its content type: JSON is
+// pretty-printed, CSV becomes a table on terminals, and binary data is never
+// written to a terminal.
+func printBody(resp *client.Response, raw bool) error {
+	contentType := resp.Header.Get("Content-Type")
+
+	switch classify(contentType, resp.Body) {
+	case kindCSV:
+		if !raw && isTerminal(os.Stdout) {
+			return printCSVTable(os.Stdout, resp.Body)
+		}
+		return writeText(resp.Body)
+	case kindText:
+		return writeText(resp.Body)
+	case kindBinary:
+		if isTerminal(os.Stdout) {
+			if contentType == "" {
+				contentType = "binary data"
+			}
+			return fmt.Errorf("refusing to write %s (%d bytes) to a terminal; use -o FILE or redirect stdout", contentType, len(resp.Body))
+		}
+		_, err := os.Stdout.Write(resp.Body)
+		return err
 	}
 
 	if raw {
@@ -27,6 +90,122 @@ func Print(resp *client.Response, raw bool) error {
 	return prettyPrint(resp.Body)
 }
 
+// printJQ writes the results of opts.JQ on the body to stdout or
+// opts.OutputFile.
+func printJQ(resp *client.Response, opts Options) error {
+	if contentType := resp.Header.Get("Content-Type"); classify(contentType, resp.Body) != kindJSON {
+		if contentType == "" {
+			contentType = "binary data"
+		}
+		return fmt.Errorf("--jq: response is not JSON (%s)", contentType)
+	}
+	var buf bytes.Buffer
+	if err := opts.JQ.Run(&buf, resp.Body, opts.Raw, opts.RawStrings); err != nil {
+		return err
+	}
+	if opts.OutputFile != "" {
+		return writeFileAtomic(opts.OutputFile, buf.Bytes())
+	}
+	_, err := os.Stdout.Write(buf.Bytes())
+	return err
+}
+
+// writeText writes a text body, adding a trailing newline if it lacks one.
+func writeText(data []byte) error {
+	if _, err := os.Stdout.Write(data); err != nil {
+		return err
+	}
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		fmt.Println()
+	}
+	return nil
+}
+
+// PrintItems writes items collected from several pages as one JSON array.
+func PrintItems(items []json.RawMessage, opts Options) error {
+	return PrintPages(&client.Response{StatusCode: 200}, items, opts)
+}
+
+// PrintPages writes the items of a paginated walk like PrintItems, with the
+// status line and headers of last, the last page fetched, for --include and
+// --header-out.
+func PrintPages(last *client.Response, items []json.RawMessage, opts Options) error {
+	if items == nil {
+		items = []json.RawMessage{}
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("encoding items: %w", err)
+	}
+	resp := *last
+	resp.Body = data
+	return Print(&resp, opts)
+}
+
+// PrintNDJSON writes each item as a compact JSON document on its own line,
+// or the results of opts.JQ on each item.
+func PrintNDJSON(items []json.RawMessage, opts Options) error {
+	for _, item := range items {
+		var buf bytes.Buffer
+		if opts.JQ != nil {
+			if err := opts.JQ.Run(&buf, item, true, opts.RawStrings); err != nil {
+				return err
+			}
+		} else {
+			if err := json.Compact(&buf, item); err != nil {
+				return fmt.Errorf("encoding item: %w", err)
+			}
+			buf.WriteByte('\n')
+		}
+		if _, err := os.Stdout.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printMeta handles --status and --header-out: only the status code and/or the
+// requested header values are printed, one per line. A missing header prints
+// an empty line so positions stay stable for scripts.
+func printMeta(resp *client.Response, opts Options) error {
+	if opts.StatusOnly {
+		fmt.Println(resp.StatusCode)
+	}
+	for _, name := range opts.Headers {
+		fmt.Println(strings.Join(resp.Header.Values(name), ", "))
+	}
+	if resp.StatusCode >= 400 {
+		return httpError(resp)
+	}
+	return nil
+}
+
+// printStatusAndHeaders writes an HTTP/1.x style status line and the sorted
+// response headers followed by a blank line.
+func printStatusAndHeaders(w io.Writer, resp *client.Response) {
+	proto := resp.Proto
+	if proto == "" {
+		proto = "HTTP/1.1"
+	}
+	status := resp.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	fmt.Fprintf(w, "%s %s\n", proto, status)
+
+	keys := make([]string, 0, len(resp.Header))
+	for k := range resp.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range resp.Header[k] {
+			fmt.Fprintf(w, "%s: %s\n", k, v)
+		}
+	}
+	fmt.Fprintln(w)
+}
+
 func printError(resp *client.Response, raw bool) error {
 	if raw {
 		os.Stderr.Write(resp.Body)
@@ -40,7 +219,23 @@ func printError(resp *client.Response, raw bool) error {
 			fmt.Fprintln(os.Stderr, pretty)
 		}
 	}
-	return fmt.Errorf("HTTP %d", resp.StatusCode)
+	return httpError(resp)
+}
+
+// httpError describes a failed response, including the correlation IDs
+// Nuon support needs to find the request in server logs.
+func httpError(resp *client.Response) error {
+	var ids []string
+	if resp.RequestID != "" {
+		ids = append(ids, "request id "+resp.RequestID)
+	}
+	if resp.ServerRequestID != "" {
+		ids = append(ids, "server request id "+resp.ServerRequestID)
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return fmt.Errorf("HTTP %d (%s)", resp.StatusCode, strings.Join(ids, ", "))
 }
 
 func prettyPrint(data []byte) error {
@@ -0,0 +1,36 @@
+package output
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/nuonco/nuon-ext-api/internal/client"
+)
+
+func TestPrintStatusAndHeadersSortsHeaders(t *testing.T) {
+	resp := &client.Response{
+		StatusCode: 404,
+		Header: http.Header{
+			"X-Request-Id": {"req_1"},
+			"Content-Type": {"application/json"},
+		},
+	}
+
+	var buf bytes.Buffer
+	printStatusAndHeaders(&buf, resp)
+
+	want := "HTTP/1.1 404 Not Found\nContent-Type: application/json\nX-Request-Id: req_1\n\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("unexpected output:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestHTTPErrorIncludesCorrelationIDs(t *testing.T) {
+	err := httpError(&client.Response{StatusCode: 500, RequestID: "req_abc", ServerRequestID: "trace-1"})
+
+	want := "HTTP 500 (request id req_abc, server request id trace-1)"
+	if err.Error() != want {
+		t.Fatalf("unexpected error: got %q want %q", err.Error(), want)
+	}
+}
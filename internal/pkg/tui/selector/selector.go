@@ -1,8 +1,15 @@
 package selector
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -10,63 +17,122 @@ import (
 	"github.com/nuonco/nuon-ext-api/internal/pkg/tui"
 )
 
+// searchDebounce is how long the filter text must stay unchanged before it is
+// sent to the server.
+const searchDebounce = 300 * time.Millisecond
+
+// previewMinWidth is the narrowest terminal that fits the preview pane.
+const previewMinWidth = 100
+
+// loadAhead is how close to the last loaded item the cursor gets before the
+// next page is fetched.
+const loadAhead = 5
+
 // Result is returned after the selector exits.
 type Result struct {
 	ID       string
 	Name     string
 	Selected bool
+
+	// All lists every resource picked when Source.Multi is set, in the
+	// order they were picked. ID and Name are those of the first.
+	All []Resource
+}
+
+// Column is a contextual field shown beside a resource, e.g. its status.
+type Column struct {
+	Label string // aligns values of the same field across rows
+	Value string
 }
 
 // Resource represents one item from an API list response.
 type Resource struct {
-	ID   string
-	Name string
+	ID      string
+	Name    string
+	Columns []Column
+	Raw     json.RawMessage // the list item, shown in the preview pane
+	Recent  bool            // picked recently; shown with a marker
+
+	row   string // ID and Columns aligned with the other rows
+	check string // "[ ] " or "[x] " in multi-select mode
 }
 
-func (r Resource) Title() string       { return r.Name }
-func (r Resource) Description() string { return r.ID }
-func (r Resource) FilterValue() string { return r.Name + " " + r.ID }
+func (r Resource) Title() string {
+	if r.Recent {
+		return r.check + r.Name + "  · recent"
+	}
+	return r.check + r.Name
+}
 
-// Run launches an interactive selector for the given resources.
-func Run(paramName string, resources []Resource) (*Result, error) {
-	if len(resources) == 0 {
-		return nil, fmt.Errorf("no resources available for {%s}", paramName)
+func (r Resource) Description() string {
+	if r.row != "" {
+		return r.row
 	}
+	return r.ID
+}
 
-	items := make([]list.Item, len(resources))
-	for i, r := range resources {
-		items[i] = r
+func (r Resource) FilterValue() string {
+	values := []string{r.Name, r.ID}
+	for _, c := range r.Columns {
+		values = append(values, c.Value)
 	}
+	return strings.Join(values, " ")
+}
 
-	delegate := list.NewDefaultDelegate()
-	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.
-		Foreground(tui.PrimaryColor).
-		BorderLeftForeground(tui.PrimaryColor)
-	delegate.Styles.SelectedDesc = delegate.Styles.SelectedDesc.
-		Foreground(tui.SubtleColor).
-		BorderLeftForeground(tui.PrimaryColor)
+// Page is one page of resources returned by a Source.
+type Page struct {
+	Resources []Resource
+	Next      int  // offset of the following page
+	More      bool // another page follows
+}
 
-	l := list.New(items, delegate, 60, 16)
-	l.Title = fmt.Sprintf("Select {%s}", paramName)
-	l.Styles.Title = lipgloss.NewStyle().
-		Foreground(tui.TextColor).
-		Background(tui.PrimaryColor).
-		Padding(0, 1)
-	l.SetShowStatusBar(true)
-	l.SetFilteringEnabled(true)
+// Source supplies the selector's resources a page at a time.
+type Source struct {
+	// Load fetches the page at offset. query is the filter text when Search
+	// is set, otherwise always "".
+	Load func(ctx context.Context, query string, offset int) (Page, error)
+	// Search means Load filters by query on the server. Otherwise the filter
+	// only matches loaded resources, so all pages load while filtering.
+	Search bool
+	// Recent resources are pinned above the loaded ones while not searching.
+	Recent []Resource
+	// Multi lets several resources be picked: space toggles the highlighted
+	// one and a toggles all that match the filter.
+	Multi bool
+}
+
+// ErrInterrupted is returned by Run when the user presses ctrl+c.
+var ErrInterrupted = errors.New("selection interrupted")
 
-	m := model{list: l}
-	p := tea.NewProgram(m, tea.WithAltScreen())
+// Run launches an interactive selector that loads resources from src as the
+// user scrolls or searches. The first page is loaded before the selector
+// opens. The selector is torn down when ctx is cancelled. With src.Multi,
+// enter returns the picked resources, or the highlighted one if none are.
+func Run(ctx context.Context, paramName string, src Source) (*Result, error) {
+	first, err := src.Load(ctx, "", 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(first.Resources) == 0 && len(src.Recent) == 0 {
+		return nil, fmt.Errorf("no resources available for {%s}", paramName)
+	}
+
+	m := newModel(ctx, paramName, src, first)
+	p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithContext(ctx))
 	result, err := p.Run()
 	if err != nil {
 		return nil, err
 	}
+	if final, ok := result.(model); ok && final.interrupted {
+		return nil, ErrInterrupted
+	}
 
 	if final, ok := result.(model); ok && final.selected != nil {
 		return &Result{
 			ID:       final.selected.ID,
 			Name:     final.selected.Name,
 			Selected: true,
+			All:      final.picked,
 		}, nil
 	}
 
@@ -74,37 +140,405 @@ func Run(paramName string, resources []Resource) (*Result, error) {
 }
 
 type model struct {
-	list     list.Model
-	selected *Resource
+	ctx context.Context
+	src Source
+
+	list        list.Model
+	selected    *Resource
+	interrupted bool // quit with ctrl+c
+
+	picked   []Resource      // multi-select picks, in pick order
+	isPicked map[string]bool // IDs in picked
+
+	width, height int
+	preview       bool // show the highlighted item's JSON beside the list
+
+	loaded  []Resource
+	seen    map[string]bool // IDs in loaded
+	next    int             // offset of the next page
+	more    bool
+	pages   int
+	loading bool
+	err     error
+
+	query string // search the loaded pages belong to
+	typed string // filter text last seen, pending the debounce
+	gen   int    // bumped when query changes; pages of older queries are dropped
+	edits int    // bumped on each filter edit; older debounce ticks are dropped
+}
+
+// pageMsg delivers a page requested by loadNext.
+type pageMsg struct {
+	gen  int
+	page Page
+	err  error
+}
+
+// searchMsg fires searchDebounce after a filter edit.
+type searchMsg struct {
+	edits int
+	query string
+}
+
+func newModel(ctx context.Context, paramName string, src Source, first Page) model {
+	delegate := list.NewDefaultDelegate()
+	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.
+		Foreground(tui.PrimaryColor).
+		BorderLeftForeground(tui.PrimaryColor)
+	delegate.Styles.SelectedDesc = delegate.Styles.SelectedDesc.
+		Foreground(tui.SubtleColor).
+		BorderLeftForeground(tui.PrimaryColor)
+
+	l := list.New(nil, delegate, 60, 16)
+	l.Title = fmt.Sprintf("Select {%s}", paramName)
+	l.Styles.Title = lipgloss.NewStyle().
+		Foreground(tui.TextColor).
+		Background(tui.PrimaryColor).
+		Padding(0, 1)
+	l.SetShowStatusBar(true)
+	l.SetFilteringEnabled(true)
+
+	l.AdditionalShortHelpKeys = func() []key.Binding {
+		keys := []key.Binding{key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "preview"))}
+		if src.Multi {
+			keys = append(keys,
+				key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "pick")),
+				key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "pick all")),
+			)
+		}
+		return keys
+	}
+
+	m := model{ctx: ctx, src: src, list: l, seen: make(map[string]bool), isPicked: make(map[string]bool), preview: true}
+	m.addPage(first)
+	m.list.SetItems(m.items())
+	return m
 }
 
 func (m model) Init() tea.Cmd { return nil }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.list.SetSize(msg.Width, msg.Height)
-		return m, nil
+		m.width, m.height = msg.Width, msg.Height
+		m.resize()
+		return m, m.loadIfNeeded()
 	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			m.interrupted = true
+			return m, tea.Quit
+		}
 		if m.list.FilterState() == list.Filtering {
 			break
 		}
 		switch msg.String() {
-		case "q", "ctrl+c":
+		case "q":
 			return m, tea.Quit
-		case "enter":
+		case "p":
+			m.preview = !m.preview
+			m.resize()
+			return m, nil
+		case " ":
+			if !m.src.Multi {
+				break
+			}
 			if item, ok := m.list.SelectedItem().(Resource); ok {
+				m.toggle(item, !m.isPicked[item.ID])
+			}
+			return m, m.list.SetItems(m.items())
+		case "a":
+			if !m.src.Multi {
+				break
+			}
+			m.toggleVisible()
+			return m, m.list.SetItems(m.items())
+		case "enter":
+			item, ok := m.list.SelectedItem().(Resource)
+			if m.src.Multi && len(m.picked) == 0 && ok {
+				m.toggle(item, true)
+			}
+			if len(m.picked) > 0 {
+				item, ok = m.picked[0], true
+			}
+			if ok {
 				m.selected = &item
 			}
 			return m, tea.Quit
 		}
+	case pageMsg:
+		if msg.gen != m.gen {
+			return m, nil
+		}
+		m.loading = false
+		m.list.StopSpinner()
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.addPage(msg.page)
+		cmds = append(cmds, m.list.SetItems(m.items()))
+		return m, tea.Batch(append(cmds, m.loadIfNeeded())...)
+	case searchMsg:
+		if msg.edits != m.edits || msg.query == m.query {
+			return m, nil
+		}
+		m.startSearch(msg.query)
+		cmds = append(cmds, m.list.SetItems(m.items()))
+		return m, tea.Batch(append(cmds, m.loadNext())...)
 	}
 
 	var cmd tea.Cmd
 	m.list, cmd = m.list.Update(msg)
-	return m, cmd
+	cmds = append(cmds, cmd)
+
+	if m.src.Search {
+		if typed := strings.TrimSpace(m.list.FilterValue()); typed != m.typed {
+			m.typed = typed
+			m.edits++
+			edits := m.edits
+			cmds = append(cmds, tea.Tick(searchDebounce, func(time.Time) tea.Msg {
+				return searchMsg{edits: edits, query: typed}
+			}))
+		}
+	}
+	cmds = append(cmds, m.loadIfNeeded())
+	return m, tea.Batch(cmds...)
 }
 
 func (m model) View() string {
-	return m.list.View()
+	view := m.list.View()
+	if m.showPreview() {
+		view = lipgloss.JoinHorizontal(lipgloss.Top, view, m.previewView())
+	}
+	return view + "\n" + m.statusLine()
+}
+
+func (m model) showPreview() bool {
+	return m.preview && m.width >= previewMinWidth
+}
+
+// resize splits the window between the list and the preview pane, leaving a
+// line for the page status.
+func (m *model) resize() {
+	width := m.width
+	if m.showPreview() {
+		width = m.width / 2
+	}
+	m.list.SetSize(width, m.height-1)
+}
+
+// previewView renders the highlighted item's JSON in a bordered pane filling
+// the width beside the list.
+func (m model) previewView() string {
+	width, height := m.width-m.list.Width(), m.height-1
+	body := lipgloss.NewStyle().Foreground(tui.SubtleColor).Render("no details for this item")
+	if r, ok := m.list.SelectedItem().(Resource); ok && len(r.Raw) > 0 {
+		var buf bytes.Buffer
+		if json.Indent(&buf, r.Raw, "", "  ") == nil {
+			body = buf.String()
+		} else {
+			body = string(r.Raw)
+		}
+	}
+
+	// Clip before bordering so long lines are cut rather than wrapped.
+	body = lipgloss.NewStyle().MaxWidth(width - 4).MaxHeight(height - 2).Render(body)
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(tui.SubtleColor).
+		Padding(0, 1).
+		Width(width - 2).
+		Height(height - 2).
+		Render(body)
+}
+
+// toggle adds r to or removes it from the multi-select picks.
+func (m *model) toggle(r Resource, pick bool) {
+	if m.isPicked[r.ID] == pick {
+		return
+	}
+	m.isPicked[r.ID] = pick
+	if pick {
+		r.Recent, r.check = false, ""
+		m.picked = append(m.picked, r)
+		return
+	}
+	for i, p := range m.picked {
+		if p.ID == r.ID {
+			m.picked = append(m.picked[:i], m.picked[i+1:]...)
+			break
+		}
+	}
+}
+
+// toggleVisible picks every resource matching the filter, or unpicks them
+// all when they are already picked.
+func (m *model) toggleVisible() {
+	visible := m.list.VisibleItems()
+	all := true
+	for _, item := range visible {
+		if !m.isPicked[item.(Resource).ID] {
+			all = false
+		}
+	}
+	for _, item := range visible {
+		m.toggle(item.(Resource), !all)
+	}
+}
+
+// items is what the list shows: recent resources (unless searching), then
+// the loaded ones that are not among them, with their columns aligned.
+func (m model) items() []list.Item {
+	var resources []Resource
+	pinned := make(map[string]bool)
+	if m.query == "" {
+		loaded := make(map[string]Resource, len(m.loaded))
+		for _, r := range m.loaded {
+			loaded[r.ID] = r
+		}
+		for _, r := range m.src.Recent {
+			if full, ok := loaded[r.ID]; ok {
+				r = full
+			}
+			r.Recent = true
+			pinned[r.ID] = true
+			resources = append(resources, r)
+		}
+	}
+	for _, r := range m.loaded {
+		if !pinned[r.ID] {
+			resources = append(resources, r)
+		}
+	}
+
+	alignRows(resources)
+	items := make([]list.Item, len(resources))
+	for i, r := range resources {
+		if m.src.Multi {
+			r.check = "[ ] "
+			if m.isPicked[r.ID] {
+				r.check = "[x] "
+			}
+		}
+		items[i] = r
+	}
+	return items
+}
+
+// alignRows renders each resource's ID and columns so that values with the
+// same label line up, leaving a gap where a resource lacks one.
+func alignRows(resources []Resource) {
+	var labels []string
+	widths := map[string]int{"": 0}
+	for _, r := range resources {
+		widths[""] = max(widths[""], len(r.ID))
+		for _, c := range r.Columns {
+			if _, ok := widths[c.Label]; !ok {
+				labels = append(labels, c.Label)
+			}
+			widths[c.Label] = max(widths[c.Label], lipgloss.Width(c.Value))
+		}
+	}
+	if len(labels) == 0 {
+		return
+	}
+
+	for i, r := range resources {
+		values := make(map[string]string, len(r.Columns))
+		for _, c := range r.Columns {
+			values[c.Label] = c.Value
+		}
+		var b strings.Builder
+		b.WriteString(pad(r.ID, widths[""]))
+		for _, label := range labels {
+			b.WriteString("  " + pad(values[label], widths[label]))
+		}
+		resources[i].row = strings.TrimRight(b.String(), " ")
+	}
+}
+
+func pad(s string, width int) string {
+	if n := width - lipgloss.Width(s); n > 0 {
+		return s + strings.Repeat(" ", n)
+	}
+	return s
+}
+
+// addPage appends the new resources of page. A page that adds nothing ends
+// loading, in case the endpoint ignores offset.
+func (m *model) addPage(page Page) {
+	added := 0
+	for _, r := range page.Resources {
+		if m.seen[r.ID] {
+			continue
+		}
+		m.seen[r.ID] = true
+		m.loaded = append(m.loaded, r)
+		added++
+	}
+	m.pages++
+	m.next = page.Next
+	m.more = page.More && added > 0
+}
+
+// startSearch drops the loaded pages so they can be reloaded for query.
+func (m *model) startSearch(query string) {
+	m.gen++
+	m.query = query
+	m.loaded = nil
+	m.seen = make(map[string]bool)
+	m.next, m.pages = 0, 0
+	m.more, m.loading, m.err = false, false, nil
+}
+
+// loadIfNeeded fetches the next page when the cursor nears the end of the
+// loaded resources, or while filtering resources the server can't search.
+func (m *model) loadIfNeeded() tea.Cmd {
+	if !m.more || m.loading || m.err != nil {
+		return nil
+	}
+	nearEnd := m.list.Index() >= len(m.list.VisibleItems())-loadAhead
+	localFilter := !m.src.Search && m.list.FilterState() != list.Unfiltered
+	if !nearEnd && !localFilter {
+		return nil
+	}
+	return m.loadNext()
+}
+
+func (m *model) loadNext() tea.Cmd {
+	m.loading = true
+	ctx, load := m.ctx, m.src.Load
+	gen, query, offset := m.gen, m.query, m.next
+	return tea.Batch(m.list.StartSpinner(), func() tea.Msg {
+		page, err := load(ctx, query, offset)
+		return pageMsg{gen: gen, page: page, err: err}
+	})
+}
+
+func (m model) statusLine() string {
+	parts := []string{}
+	if m.src.Multi {
+		parts = append(parts, fmt.Sprintf("%d picked", len(m.picked)))
+	}
+	if m.query != "" {
+		parts = append(parts, fmt.Sprintf("search %q", m.query))
+	}
+	pages := "pages"
+	if m.pages == 1 {
+		pages = "page"
+	}
+	parts = append(parts, fmt.Sprintf("%d loaded from %d %s", len(m.loaded), m.pages, pages))
+	switch {
+	case m.loading:
+		parts = append(parts, fmt.Sprintf("loading page %d…", m.pages+1))
+	case m.err != nil:
+		parts = append(parts, "error: "+m.err.Error())
+	case m.more:
+		parts = append(parts, "more below")
+	default:
+		parts = append(parts, "all loaded")
+	}
+	return lipgloss.NewStyle().Foreground(tui.SubtleColor).PaddingLeft(2).Render(strings.Join(parts, " · "))
 }
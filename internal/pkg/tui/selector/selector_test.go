@@ -0,0 +1,208 @@
+package selector
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// pages collects the pageMsgs produced by cmd and any commands it batches.
+func pages(cmd tea.Cmd) []pageMsg {
+	if cmd == nil {
+		return nil
+	}
+	switch msg := cmd().(type) {
+	case pageMsg:
+		return []pageMsg{msg}
+	case tea.BatchMsg:
+		var out []pageMsg
+		for _, c := range msg {
+			out = append(out, pages(c)...)
+		}
+		return out
+	}
+	return nil
+}
+
+func ids(m model) []string {
+	var out []string
+	for _, item := range m.list.Items() {
+		out = append(out, item.(Resource).ID)
+	}
+	return out
+}
+
+func TestRecentArePinnedAboveLoadedPages(t *testing.T) {
+	src := Source{Recent: []Resource{{ID: "c"}}}
+	m := newModel(context.Background(), "install_id", src, Page{Resources: []Resource{{ID: "a"}, {ID: "b"}, {ID: "c"}}})
+
+	got := ids(m)
+	if len(got) != 3 || got[0] != "c" || got[1] != "a" || got[2] != "b" {
+		t.Fatalf("expected c pinned above a, b, got %v", got)
+	}
+	if !m.list.Items()[0].(Resource).Recent {
+		t.Fatal("expected the pinned resource to be marked recent")
+	}
+}
+
+func TestPagesLoadUntilExhausted(t *testing.T) {
+	var offsets []int
+	src := Source{Load: func(_ context.Context, _ string, offset int) (Page, error) {
+		offsets = append(offsets, offset)
+		return Page{Resources: []Resource{{ID: "c"}}, Next: offset + 1}, nil
+	}}
+	m := newModel(context.Background(), "install_id", src, Page{Resources: []Resource{{ID: "a"}, {ID: "b"}}, Next: 2, More: true})
+
+	// The cursor is already within loadAhead of the end.
+	msgs := pages(m.loadIfNeeded())
+	if len(msgs) != 1 || len(offsets) != 1 || offsets[0] != 2 {
+		t.Fatalf("expected one load at offset 2, got %v", offsets)
+	}
+
+	next, _ := m.Update(msgs[0])
+	m = next.(model)
+	if got := ids(m); len(got) != 3 || m.more || m.pages != 2 {
+		t.Fatalf("expected 3 resources from 2 pages and no more, got %v (pages %d, more %t)", got, m.pages, m.more)
+	}
+
+	stale, _ := m.Update(pageMsg{gen: m.gen - 1, page: Page{Resources: []Resource{{ID: "z"}}}})
+	if len(ids(stale.(model))) != 3 {
+		t.Fatal("expected a page from an older search to be dropped")
+	}
+}
+
+func TestSearchReloadsWithQuery(t *testing.T) {
+	var queries []string
+	src := Source{
+		Search: true,
+		Recent: []Resource{{ID: "r"}},
+		Load: func(_ context.Context, query string, _ int) (Page, error) {
+			queries = append(queries, query)
+			return Page{Resources: []Resource{{ID: "acme"}}}, nil
+		},
+	}
+	m := newModel(context.Background(), "install_id", src, Page{Resources: []Resource{{ID: "a"}}})
+
+	m.edits = 2
+	if _, cmd := m.Update(searchMsg{edits: 1, query: "ac"}); cmd != nil {
+		t.Fatal("expected a superseded debounce tick to be ignored")
+	}
+
+	next, cmd := m.Update(searchMsg{edits: 2, query: "acme"})
+	m = next.(model)
+	if len(ids(m)) != 0 {
+		t.Fatalf("expected loaded and recent resources to be cleared while searching, got %v", ids(m))
+	}
+
+	msgs := pages(cmd)
+	if len(msgs) != 1 || len(queries) != 1 || queries[0] != "acme" {
+		t.Fatalf("expected one load for %q, got %v", "acme", queries)
+	}
+	next, _ = m.Update(msgs[0])
+	if got := ids(next.(model)); len(got) != 1 || got[0] != "acme" {
+		t.Fatalf("expected the search results, got %v", got)
+	}
+}
+
+func TestRowsAlignColumnsByLabel(t *testing.T) {
+	resources := []Resource{
+		{ID: "ins_1", Columns: []Column{{Label: "status", Value: "active"}, {Label: "platform", Value: "aws"}}},
+		{ID: "ins_22", Columns: []Column{{Label: "platform", Value: "azure"}}},
+	}
+
+	alignRows(resources)
+
+	if resources[0].row != "ins_1   active  aws" || resources[1].row != "ins_22          azure" {
+		t.Fatalf("unexpected rows:\n%q\n%q", resources[0].row, resources[1].row)
+	}
+}
+
+func TestViewFitsWindowWithPreview(t *testing.T) {
+	raw := json.RawMessage(`{"id":"ins_1","name":"` + strings.Repeat("x", 200) + `"}`)
+	m := newModel(context.Background(), "install_id", Source{}, Page{Resources: []Resource{{ID: "ins_1", Name: "prod-us", Raw: raw}}})
+
+	next, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	m = next.(model)
+	if !m.showPreview() {
+		t.Fatal("expected the preview pane on a wide terminal")
+	}
+
+	lines := strings.Split(m.View(), "\n")
+	if len(lines) > 30 {
+		t.Fatalf("expected at most 30 lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if w := lipgloss.Width(line); w > 120 {
+			t.Fatalf("expected lines to fit 120 columns, got %d: %q", w, line)
+		}
+	}
+	if !strings.Contains(m.View(), `"name": "xxx`) {
+		t.Fatal("expected the highlighted item's JSON in the preview")
+	}
+
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if next.(model).showPreview() {
+		t.Fatal("expected p to hide the preview")
+	}
+}
+
+func TestMultiSelectTogglesAndPicksAllFiltered(t *testing.T) {
+	page := Page{Resources: []Resource{{ID: "a", Name: "prod-us"}, {ID: "b", Name: "dev"}, {ID: "c", Name: "prod-eu"}}}
+	m := newModel(context.Background(), "install_id", Source{Multi: true}, page)
+	key := func(m model, k string) model {
+		next, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+		return next.(model)
+	}
+
+	m = key(m, " ")
+	if len(m.picked) != 1 || m.picked[0].ID != "a" {
+		t.Fatalf("expected space to pick the highlighted item, got %v", m.picked)
+	}
+	if title := m.list.Items()[0].(Resource).Title(); title != "[x] prod-us" {
+		t.Fatalf("expected the pick to be marked, got %q", title)
+	}
+	m = key(m, " ")
+	if len(m.picked) != 0 {
+		t.Fatalf("expected a second space to unpick, got %v", m.picked)
+	}
+
+	m.list.SetFilterText("prod")
+	m = key(m, "a")
+	if len(m.picked) != 2 || m.picked[0].ID != "a" || m.picked[1].ID != "c" {
+		t.Fatalf("expected a to pick the filtered items, got %v", m.picked)
+	}
+
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(model)
+	if m.selected == nil || m.selected.ID != "a" || len(m.picked) != 2 {
+		t.Fatalf("expected enter to return both picks, got %v", m.picked)
+	}
+}
+
+func TestCtrlCInterruptsEvenWhileFiltering(t *testing.T) {
+	page := Page{Resources: []Resource{{ID: "a", Name: "prod-us"}, {ID: "b", Name: "dev"}}}
+	m := newModel(context.Background(), "install_id", Source{}, page)
+
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if next.(model).interrupted {
+		t.Fatal("expected q to quit without interrupting")
+	}
+
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	m = next.(model)
+	if m.list.FilterState() != list.Filtering {
+		t.Fatal("expected / to start filtering")
+	}
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if !next.(model).interrupted || cmd == nil {
+		t.Fatal("expected ctrl+c to interrupt the selector")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Fatal("expected ctrl+c to quit")
+	}
+}
@@ -0,0 +1,98 @@
+package recent
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/nuonco/nuon-ext-api/internal/atomicfile"
+)
+
+// MaxPerScope is how many choices are remembered for each parameter and
+// scope, so picks from one listing never push out those of another.
+const MaxPerScope = 5
+
+// Choice is a value picked in the selector.
+type Choice struct {
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Scope string `json:"scope,omitempty"` // list path it was picked from, e.g. /v1/apps/app_1/installs
+}
+
+// Store keeps the most recent choices per parameter for one API host and org,
+// most recent first, in a JSON file under Dir.
+type Store struct {
+	Dir string
+	Key string // API host and org the choices belong to
+}
+
+// New returns the store for apiURL and orgID under dir.
+func New(dir, apiURL, orgID string) *Store {
+	return &Store{Dir: dir, Key: apiURL + "\n" + orgID}
+}
+
+// Get returns the remembered choices for param, most recent first.
+// A missing or unreadable file has none.
+func (s *Store) Get(param string) []Choice {
+	return s.load()[param]
+}
+
+// Last returns the most recent choice for param picked from scope.
+func (s *Store) Last(param, scope string) (Choice, bool) {
+	for _, c := range s.Get(param) {
+		if c.Scope == scope {
+			return c, true
+		}
+	}
+	return Choice{}, false
+}
+
+// Add records c as the most recent choice for param, dropping an older entry
+// with the same ID and scope and anything beyond MaxPerScope in c's scope.
+func (s *Store) Add(param string, c Choice) error {
+	all := s.load()
+	list := []Choice{c}
+	inScope := 1
+	for _, old := range all[param] {
+		if old.Scope == c.Scope {
+			if old.ID == c.ID || inScope == MaxPerScope {
+				continue
+			}
+			inScope++
+		}
+		list = append(list, old)
+	}
+	all[param] = list
+	return s.save(all)
+}
+
+func (s *Store) path() string {
+	sum := sha256.Sum256([]byte(s.Key))
+	return filepath.Join(s.Dir, hex.EncodeToString(sum[:16])+".json")
+}
+
+func (s *Store) load() map[string][]Choice {
+	all := make(map[string][]Choice)
+	data, err := os.ReadFile(s.path())
+	if err != nil {
+		return all
+	}
+	if json.Unmarshal(data, &all) != nil || all == nil {
+		return make(map[string][]Choice)
+	}
+	return all
+}
+
+// save writes all atomically so concurrent invocations never see half a file.
+func (s *Store) save(all map[string][]Choice) error {
+	data, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.Dir, 0o700); err != nil {
+		return err
+	}
+	return atomicfile.Write(s.path(), data, 0o600)
+}
@@ -0,0 +1,59 @@
+package recent
+
+import "testing"
+
+func TestAddKeepsMostRecentFirstAndCapped(t *testing.T) {
+	s := New(t.TempDir(), "https://api.nuon.co", "org_1")
+
+	for _, id := range []string{"a", "b", "c", "d", "e", "f", "b"} {
+		if err := s.Add("install_id", Choice{ID: id, Scope: "/v1/installs"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := s.Get("install_id")
+	want := []string{"b", "f", "e", "d", "c"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d choices, got %+v", len(want), got)
+	}
+	for i, id := range want {
+		if got[i].ID != id {
+			t.Fatalf("expected %v, got %+v", want, got)
+		}
+	}
+}
+
+func TestAddCapsEachScopeSeparately(t *testing.T) {
+	s := New(t.TempDir(), "https://api.nuon.co", "org_1")
+	s.Add("install_id", Choice{ID: "ins_x", Scope: "/v1/apps/app_2/installs"})
+	for _, id := range []string{"a", "b", "c", "d", "e", "f"} {
+		if err := s.Add("install_id", Choice{ID: id, Scope: "/v1/apps/app_1/installs"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	counts := make(map[string]int)
+	for _, c := range s.Get("install_id") {
+		counts[c.Scope]++
+	}
+	if counts["/v1/apps/app_1/installs"] != MaxPerScope {
+		t.Fatalf("expected %d choices for app_1, got %v", MaxPerScope, counts)
+	}
+	if c, ok := s.Last("install_id", "/v1/apps/app_2/installs"); !ok || c.ID != "ins_x" {
+		t.Fatalf("expected the app_2 choice to survive app_1 picks, got %+v, %v", c, ok)
+	}
+}
+
+func TestLastMatchesScopeAndOrg(t *testing.T) {
+	dir := t.TempDir()
+	s := New(dir, "https://api.nuon.co", "org_1")
+	s.Add("install_id", Choice{ID: "ins_a", Scope: "/v1/apps/app_1/installs"})
+	s.Add("install_id", Choice{ID: "ins_b", Scope: "/v1/apps/app_2/installs"})
+
+	if c, ok := s.Last("install_id", "/v1/apps/app_1/installs"); !ok || c.ID != "ins_a" {
+		t.Fatalf("expected ins_a for app_1, got %+v, %v", c, ok)
+	}
+	if _, ok := New(dir, "https://api.nuon.co", "org_2").Last("install_id", "/v1/apps/app_2/installs"); ok {
+		t.Fatal("expected choices to be scoped to the org")
+	}
+}
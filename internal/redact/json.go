@@ -0,0 +1,79 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// secretKeyParts mark JSON fields whose values look like credentials.
+var secretKeyParts = []string{
+	"token",
+	"password",
+	"passwd",
+	"secret",
+	"private_key",
+	"privatekey",
+	"api_key",
+	"apikey",
+	"access_key",
+	"credential",
+	"authorization",
+}
+
+// IsSecretKey reports whether a field name looks like it holds a credential.
+func IsSecretKey(key string) bool {
+	k := strings.ToLower(key)
+	for _, part := range secretKeyParts {
+		if strings.Contains(k, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// JSON returns body with the values of secret-looking fields replaced by
+// Placeholder, at any depth. Bodies that are not JSON are returned unchanged.
+func JSON(body []byte) []byte {
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+	if !redactValue(v) {
+		return body
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return out
+}
+
+// redactValue redacts v in place and reports whether anything changed.
+func redactValue(v any) bool {
+	changed := false
+	switch t := v.(type) {
+	case map[string]any:
+		for k, child := range t {
+			if IsSecretKey(k) && child != nil {
+				if _, nested := child.(map[string]any); !nested {
+					t[k] = Placeholder
+					changed = true
+					continue
+				}
+			}
+			if redactValue(child) {
+				changed = true
+			}
+		}
+	case []any:
+		for _, child := range t {
+			if redactValue(child) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,45 @@
+package redact
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// Placeholder replaces redacted values.
+const Placeholder = "REDACTED"
+
+// sensitiveHeaders are never written to disk or logs verbatim.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Nuon-Api-Token",
+}
+
+// Header returns a copy of h with credential-bearing headers replaced by Placeholder.
+func Header(h http.Header) http.Header {
+	out := h.Clone()
+	if out == nil {
+		return http.Header{}
+	}
+	for _, name := range sensitiveHeaders {
+		if len(out.Values(name)) > 0 {
+			out.Set(name, Placeholder)
+		}
+	}
+	return out
+}
+
+// Query returns a copy of q with the values of secret-looking parameters
+// replaced by Placeholder.
+func Query(q url.Values) url.Values {
+	out := make(url.Values, len(q))
+	for k, v := range q {
+		if IsSecretKey(k) {
+			v = []string{Placeholder}
+		}
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,43 @@
+package redact
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestHeaderRedactsCredentials(t *testing.T) {
+	h := http.Header{
+		"Authorization": {"Bearer secret-token"},
+		"X-Nuon-Org-Id": {"org_123"},
+	}
+
+	got := Header(h)
+	if got.Get("Authorization") != Placeholder {
+		t.Fatalf("expected Authorization to be redacted, got %q", got.Get("Authorization"))
+	}
+	if got.Get("X-Nuon-Org-Id") != "org_123" {
+		t.Fatalf("expected org header to be kept, got %q", got.Get("X-Nuon-Org-Id"))
+	}
+	if h.Get("Authorization") != "Bearer secret-token" {
+		t.Fatal("expected the original header to be left untouched")
+	}
+}
+
+func TestJSONRedactsSecretFields(t *testing.T) {
+	body := []byte(`{"name":"prod","config":{"api_token":"abc","password":"hunter2"},"items":[{"client_secret":"s"}]}`)
+
+	got := string(JSON(body))
+	for _, secret := range []string{"abc", "hunter2", `"s"`} {
+		if strings.Contains(got, secret) {
+			t.Fatalf("expected %s to be redacted, got %s", secret, got)
+		}
+	}
+	if !strings.Contains(got, `"name":"prod"`) {
+		t.Fatalf("expected non-secret fields to be kept, got %s", got)
+	}
+
+	if string(JSON([]byte("not json"))) != "not json" {
+		t.Fatal("expected non-JSON bodies to be returned unchanged")
+	}
+}
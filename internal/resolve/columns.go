@@ -0,0 +1,84 @@
+package resolve
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nuonco/nuon-ext-api/internal/pkg/tui/selector"
+)
+
+// now is the clock the age columns are relative to.
+var now = time.Now
+
+// column is a contextual field shown beside a resource in the selector.
+// Keys are tried in order; a dotted key reaches into a nested object, e.g.
+// status.status for workflows, whose status is a composite object.
+type column struct {
+	Label  string
+	Prefix string // written before the value, e.g. "runner "
+	Keys   []string
+	Age    bool // the value is a timestamp, shown as its age
+}
+
+var resourceColumns = []column{
+	{Label: "status", Keys: []string{"status_v2.status", "status.status", "status"}},
+	{Label: "app", Prefix: "app ", Keys: []string{"app.display_name", "app.name", "app_name"}},
+	{Label: "platform", Keys: []string{"cloud_platform"}},
+	{Label: "runner", Prefix: "runner ", Keys: []string{"runner_status"}},
+	{Label: "created", Prefix: "created ", Keys: []string{"created_at"}, Age: true},
+	{Label: "updated", Prefix: "updated ", Keys: []string{"updated_at"}, Age: true},
+}
+
+// itemColumns returns the resourceColumns item has values for.
+func itemColumns(item map[string]any) []selector.Column {
+	var cols []selector.Column
+	for _, col := range resourceColumns {
+		v := ""
+		for _, key := range col.Keys {
+			if v = pathString(item, key); v != "" {
+				break
+			}
+		}
+		if v == "" {
+			continue
+		}
+		if col.Age {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				continue
+			}
+			v = age(now().Sub(t), t)
+		}
+		cols = append(cols, selector.Column{Label: col.Label, Value: col.Prefix + v})
+	}
+	return cols
+}
+
+// pathString returns the string at a dotted key in obj, or "".
+func pathString(obj map[string]any, key string) string {
+	parts := strings.Split(key, ".")
+	for _, p := range parts[:len(parts)-1] {
+		nested, ok := obj[p].(map[string]any)
+		if !ok {
+			return ""
+		}
+		obj = nested
+	}
+	return stringField(obj, parts[len(parts)-1])
+}
+
+// age renders d compactly ("5m ago", "3d ago"), or t's date beyond a month.
+func age(d time.Duration, t time.Time) string {
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	case d < 30*24*time.Hour:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	}
+	return t.Format("2006-01-02")
+}
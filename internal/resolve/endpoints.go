@@ -0,0 +1,135 @@
+package resolve
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/nuonco/nuon-ext-api/internal/config"
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+// listSource is an endpoint that lists the candidates for a path parameter.
+type listSource struct {
+	Path    string // path template, e.g. /v1/apps/{app_id}/components
+	IDField string // item field holding the parameter's value
+	Search  bool   // the endpoint filters by name with the q query param
+}
+
+// extraListEndpoints are listings the spec does not pair with an item route
+// (there is no GET /v1/installs/{install_id}/workflows/{workflow_id}), but
+// whose items are valid values for the parameter.
+var extraListEndpoints = map[string][]string{
+	"install_id":  {"/v1/apps/{app_id}/installs"},
+	"workflow_id": {"/v1/installs/{install_id}/workflows"},
+	"org_id":      {"/v1/orgs"},
+	"release_id":  {"/v1/apps/{app_id}/releases", "/v1/components/{component_id}/releases"},
+}
+
+// listSources returns the listings for paramName in template, most preferred
+// first: the config override, then collections from the spec and
+// extraListEndpoints ordered by how many parents scope them. Among equally
+// scoped listings, the template's own collection wins.
+func listSources(api *spec.API, template, paramName string, cfg *config.Config) []listSource {
+	var sources []listSource
+	if path := cfg.ListEndpoints[paramName]; path != "" {
+		sources = append(sources, listSource{Path: path, IDField: "id"})
+	}
+
+	candidates := make(map[string]string) // path → id field
+	for _, path := range extraListEndpoints[paramName] {
+		candidates[path] = "id"
+	}
+	if api != nil {
+		suffix := "/{" + paramName + "}"
+		for _, r := range api.Routes {
+			if !strings.HasSuffix(r.Path, suffix) {
+				continue
+			}
+			collection := strings.TrimSuffix(r.Path, suffix)
+			if _, seen := candidates[collection]; seen {
+				continue
+			}
+			if list := api.LookupByMethod(collection, "GET"); list != nil && list.Path == collection {
+				if idField, ok := listIDField(api, list, paramName); ok {
+					candidates[collection] = idField
+				}
+			}
+		}
+	}
+
+	own := ""
+	if i := strings.Index(template, "/{"+paramName+"}"); i > 0 {
+		own = template[:i]
+	}
+
+	derived := make([]listSource, 0, len(candidates))
+	for path, idField := range candidates {
+		derived = append(derived, listSource{Path: path, IDField: idField})
+	}
+	sort.Slice(derived, func(i, j int) bool {
+		di, dj := strings.Count(derived[i].Path, "{"), strings.Count(derived[j].Path, "{")
+		if di != dj {
+			return di > dj
+		}
+		if (derived[i].Path == own) != (derived[j].Path == own) {
+			return derived[i].Path == own
+		}
+		return derived[i].Path < derived[j].Path
+	})
+	return append(sources, derived...)
+}
+
+// listIDField reports whether list returns an array, and which item field
+// holds paramName's value: the field of that name if the items have one
+// (e.g. component_id on install components), otherwise "id".
+func listIDField(api *spec.API, list *spec.Route, paramName string) (string, bool) {
+	if list.Response == nil || list.Response.Type != "array" {
+		return "", false
+	}
+	if items := list.Response.Items; items != nil && items.Ref != "" {
+		if def := api.Definition(items.Ref); def != nil && def.Properties[paramName] != nil {
+			return paramName, true
+		}
+	}
+	return "id", true
+}
+
+// listEndpoint picks the first listing whose placeholders are known. Known
+// values come from resolved, then -p flags and env (e.g. NUON_INSTALL_ID
+// scopes workflows even when the path has no install).
+func listEndpoint(api *spec.API, template, paramName string, cfg *config.Config, resolved map[string]string) (listSource, bool) {
+	known := func(name string) string {
+		if v := resolved[name]; v != "" {
+			return v
+		}
+		v, _ := configuredParam(cfg, name)
+		return v
+	}
+
+	for _, source := range listSources(api, template, paramName, cfg) {
+		if path, ok := fillTemplate(source.Path, known); ok {
+			source.Search = searchable(api, source.Path)
+			source.Path = path
+			return source, true
+		}
+	}
+	return listSource{}, false
+}
+
+// searchable reports whether the spec documents a q query param for the GET
+// on path.
+func searchable(api *spec.API, path string) bool {
+	if api == nil {
+		return false
+	}
+	route := api.LookupByMethod(path, "GET")
+	if route == nil || route.Path != path {
+		return false
+	}
+	for _, p := range route.QueryParams {
+		if p.Name == "q" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+package resolve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/nuonco/nuon-ext-api/internal/client"
+	"github.com/nuonco/nuon-ext-api/internal/config"
+	"github.com/nuonco/nuon-ext-api/internal/debug"
+	"github.com/nuonco/nuon-ext-api/internal/idprefix"
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+// namePrefix marks a path segment or value as a resource name: @acme-prod.
+const namePrefix = "@"
+
+// looksLikeID reports whether v is an ID for paramName: it has the shape of a
+// Nuon resource ID (iawag6pbgfzvlkyqdiy2a1xw6j), or its type prefix (ins_123)
+// is one known for paramName from config or earlier responses. Values like
+// my_app are not IDs just because they contain "_".
+func looksLikeID(prefixes *idprefix.Store, paramName, v string) bool {
+	prefix := idprefix.Of(v)
+	if prefix == "" {
+		return false
+	}
+	if !strings.Contains(v, "_") {
+		// Of only finds a prefix without "_" in a Nuon ID.
+		return true
+	}
+	return prefixes != nil && slices.Contains(prefixes.Prefixes(paramName), prefix)
+}
+
+// resolveName returns the ID for value given for paramName. Only "@name" is
+// looked up, and it must match exactly one resource; other values are used
+// as given, so plain paths never cost a lookup. With cfg.NoNameLookup "@name"
+// is an error.
+func resolveName(ctx context.Context, api *spec.API, template, paramName, value string, cfg *config.Config, c *client.Client, resolved map[string]string) (string, error) {
+	return lookupName(ctx, api, template, paramName, value, false, nil, cfg, c, resolved)
+}
+
+// lookupName is resolveName, except that with strict every value that does
+// not look like an ID (see looksLikeID) is a name that must match, as if
+// given as @name.
+func lookupName(ctx context.Context, api *spec.API, template, paramName, value string, strict bool, prefixes *idprefix.Store, cfg *config.Config, c *client.Client, resolved map[string]string) (string, error) {
+	if !strings.HasSuffix(paramName, "_id") {
+		return value, nil
+	}
+
+	name, explicit := strings.CutPrefix(value, namePrefix)
+	if !explicit && (!strict || looksLikeID(prefixes, paramName, value)) {
+		return value, nil
+	}
+	if name == "" {
+		return "", fmt.Errorf("empty name for {%s}", paramName)
+	}
+	if cfg.NoNameLookup {
+		return "", fmt.Errorf("cannot look up {%s} named %q: name lookups are disabled; pass an ID", paramName, name)
+	}
+
+	source, ok := listEndpoint(api, template, paramName, cfg, resolved)
+	if !ok || c == nil {
+		return "", fmt.Errorf("cannot look up {%s} by name: no list endpoint known", paramName)
+	}
+	debug.Log("resolve: looking up {%s} named %q in %s", paramName, name, source.Path)
+
+	var matches []Candidate
+	_, err := c.Paginate(ctx, source.Path, nil, client.PageOptions{MaxItems: lookupMaxItems}, func(items []json.RawMessage) error {
+		matches = append(matches, matchName(items, source.IDField, name)...)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("looking up {%s} named %q: %w", paramName, name, err)
+	}
+
+	matches = preferExact(matches, name)
+	switch len(matches) {
+	case 1:
+		debug.Log("resolve: {%s} %q is %s", paramName, name, matches[0].ID)
+		return matches[0].ID, nil
+	case 0:
+		return "", fmt.Errorf("no resource named %q found for {%s} in %s", name, paramName, source.Path)
+	default:
+		return "", &AmbiguousNameError{Param: paramName, Name: name, Candidates: matches}
+	}
+}
+
+// Candidate is a resource whose name matched a lookup.
+type Candidate struct {
+	ID   string
+	Name string
+}
+
+// AmbiguousNameError is returned when a name matches several resources.
+type AmbiguousNameError struct {
+	Param      string
+	Name       string
+	Candidates []Candidate
+}
+
+func (e *AmbiguousNameError) Error() string {
+	lines := make([]string, len(e.Candidates))
+	for i, c := range e.Candidates {
+		lines[i] = fmt.Sprintf("  %s  %s", c.ID, c.Name)
+	}
+	sort.Strings(lines)
+	return fmt.Sprintf("name %q matches %d resources for {%s}; use an ID:\n%s",
+		e.Name, len(e.Candidates), e.Param, strings.Join(lines, "\n"))
+}
+
+// matchName returns the items whose name or display_name equals name,
+// ignoring case.
+func matchName(items []json.RawMessage, idField, name string) []Candidate {
+	var matches []Candidate
+	for _, raw := range items {
+		var item map[string]any
+		if err := json.Unmarshal(raw, &item); err != nil {
+			continue
+		}
+		id, _ := item[idField].(string)
+		if id == "" {
+			continue
+		}
+		objects := []map[string]any{item}
+		if nested, ok := item[strings.TrimSuffix(idField, "_id")].(map[string]any); ok && idField != "id" {
+			objects = append(objects, nested)
+		}
+		if v, ok := nameMatching(objects, name); ok {
+			matches = append(matches, Candidate{ID: id, Name: v})
+		}
+	}
+	return matches
+}
+
+// nameMatching returns the first name or display_name in objects that equals
+// name, ignoring case.
+func nameMatching(objects []map[string]any, name string) (string, bool) {
+	for _, obj := range objects {
+		for _, key := range []string{"name", "display_name"} {
+			if v := stringField(obj, key); strings.EqualFold(v, name) {
+				return v, true
+			}
+		}
+	}
+	return "", false
+}
+
+// preferExact narrows case-insensitive matches to exact ones when there are any.
+func preferExact(matches []Candidate, name string) []Candidate {
+	var exact []Candidate
+	for _, m := range matches {
+		if m.Name == name {
+			exact = append(exact, m)
+		}
+	}
+	if len(exact) > 0 {
+		return exact
+	}
+	return matches
+}
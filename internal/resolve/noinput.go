@@ -0,0 +1,99 @@
+package resolve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+
+	"github.com/nuonco/nuon-ext-api/internal/client"
+	"github.com/nuonco/nuon-ext-api/internal/config"
+	"github.com/nuonco/nuon-ext-api/internal/debug"
+)
+
+// unresolvedCandidates is how many candidates an UnresolvedParamError lists.
+const unresolvedCandidates = 5
+
+// UnresolvedParamError is returned when a placeholder has no value and the
+// selector cannot prompt for one (--no-input, NUON_NO_INPUT or no terminal).
+type UnresolvedParamError struct {
+	Param      string      // placeholder name, e.g. "install_id"
+	Reason     string      // why no prompt was shown
+	EnvVar     string      // env var that would supply the value, e.g. NUON_INSTALL_ID
+	Flag       string      // flag that would supply the value, e.g. -p install_id=<id>
+	ListPath   string      // endpoint the candidates came from; empty if none is known
+	Candidates []Candidate // the first few values from ListPath
+	More       bool        // ListPath has more candidates than listed
+}
+
+func (e *UnresolvedParamError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "cannot resolve {%s}: %s\n", e.Param, e.Reason)
+	fmt.Fprintf(&b, "  set %s=<id> or pass %s", e.EnvVar, e.Flag)
+	if len(e.Candidates) > 0 {
+		fmt.Fprintf(&b, "\n  candidates from %s:", e.ListPath)
+		for _, c := range e.Candidates {
+			fmt.Fprintf(&b, "\n    %s  %s", c.ID, c.Name)
+		}
+		if e.More {
+			b.WriteString("\n    ...")
+		}
+	}
+	return b.String()
+}
+
+// noInputReason returns why the selector must not prompt, or "" if it may.
+// The selector reads stdin and draws on stdout, so both must be terminals.
+func noInputReason(cfg *config.Config) string {
+	switch {
+	case cfg.NoInput:
+		return "prompting is disabled (--no-input / NUON_NO_INPUT)"
+	case !isTerminal(os.Stdin):
+		return "stdin is not a terminal"
+	case !isTerminal(os.Stdout):
+		return "stdout is not a terminal"
+	}
+	return ""
+}
+
+func isTerminal(f *os.File) bool {
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
+// unresolvedParam builds the error for a placeholder that cannot be prompted
+// for, listing the first few candidates from source when one is known.
+// Failing to list them is not an error; the hint is still useful without.
+func unresolvedParam(ctx context.Context, paramName, reason string, source listSource, ok bool, c *client.Client) *UnresolvedParamError {
+	e := &UnresolvedParamError{
+		Param:  paramName,
+		Reason: reason,
+		EnvVar: config.ParamEnvVar(paramName),
+		Flag:   fmt.Sprintf("-p %s=<id>", paramName),
+	}
+	if !ok || c == nil {
+		return e
+	}
+
+	var resources []Candidate
+	opts := client.PageOptions{PageSize: unresolvedCandidates + 1, MaxItems: unresolvedCandidates + 1}
+	_, err := c.Paginate(ctx, source.Path, nil, opts, func(items []json.RawMessage) error {
+		for _, r := range parseResources(items, source.IDField) {
+			resources = append(resources, Candidate{ID: r.ID, Name: r.Name})
+		}
+		return nil
+	})
+	if err != nil {
+		debug.Log("resolve: listing {%s} candidates from %s failed: %v", paramName, source.Path, err)
+		return e
+	}
+
+	e.ListPath = source.Path
+	if len(resources) > unresolvedCandidates {
+		resources, e.More = resources[:unresolvedCandidates], true
+	}
+	e.Candidates = resources
+	return e
+}
@@ -1,128 +1,348 @@
 package resolve
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/nuonco/nuon-ext-api/internal/client"
 	"github.com/nuonco/nuon-ext-api/internal/config"
+	"github.com/nuonco/nuon-ext-api/internal/debug"
+	"github.com/nuonco/nuon-ext-api/internal/idprefix"
 	"github.com/nuonco/nuon-ext-api/internal/pkg/tui/selector"
+	"github.com/nuonco/nuon-ext-api/internal/recent"
+	"github.com/nuonco/nuon-ext-api/internal/redact"
+	"github.com/nuonco/nuon-ext-api/internal/spec"
 )
 
-// envMap maps path parameter names to environment variable values.
+// envMap maps path parameter names to config fields that may be set other
+// than through NUON_<PARAM> (e.g. by embedders of pkg/nuonapi).
 var envMap = map[string]func(cfg *config.Config) string{
 	"app_id":     func(cfg *config.Config) string { return cfg.AppID },
 	"install_id": func(cfg *config.Config) string { return cfg.InstallID },
 	"org_id":     func(cfg *config.Config) string { return cfg.OrgID },
 }
 
-// listEndpoints maps path parameter names to the API endpoint that lists resources of that type.
-// The value is the path template — if it contains {app_id}, the app_id must be resolved first.
-var listEndpoints = map[string]string{
-	"app_id":                  "/v1/apps",
-	"install_id":              "/v1/installs",
-	"component_id":            "/v1/components",
-	"org_id":                  "/v1/orgs",
-	"action_workflow_id":      "/v1/action-workflows",
-	"workflow_id":             "/v1/workflows",
-	"vcs_connection_id":       "/v1/vcs-connections",
+// selectorPageSize is how many resources the selector loads at a time.
+const selectorPageSize = 50
+
+// lookupMaxItems caps how many resources a name lookup scans.
+const lookupMaxItems = 5000
+
+// Param records the value a path placeholder resolved to and its source.
+type Param struct {
+	Name   string
+	Value  string
+	Source string // e.g. "path", "flag -p", "env NUON_APP_ID", "context", "selector", "selector (multi)", "recent (--last)"
 }
 
 // PathParams resolves all {param} placeholders in a path.
-// Priority: literal values already in the path > env vars > interactive selection.
-// Returns the fully resolved path.
-func PathParams(path string, cfg *config.Config, c *client.Client) (string, error) {
-	if !strings.Contains(path, "{") {
-		return path, nil
+// Priority: literal values already in the path > -p flags > NUON_<PARAM> env
+// vars > saved context > interactive selection.
+// template is the matched route's path; it names the literal segments of path
+// so they can scope later lookups. Values that name a resource instead of
+// giving its ID ("@acme-prod", "my-app") are looked up.
+// Returns the fully resolved path and where each value came from.
+func PathParams(ctx context.Context, api *spec.API, template, path string, cfg *config.Config, c *client.Client) (string, []Param, error) {
+	expanded, err := expand(ctx, api, template, path, cfg, c, false)
+	if err != nil {
+		return "", nil, err
 	}
+	return expanded[0].Path, expanded[0].Params, nil
+}
+
+// Expanded is one resolution of a path.
+type Expanded struct {
+	Path   string
+	Params []Param
+}
 
-	// Track resolved values so scoped lookups can use them (e.g., app_id for install listing)
-	resolved := make(map[string]string)
+// ExpandPathParams resolves path like PathParams, except that with cfg.Multi
+// the last placeholder without a configured value is multi-selected and the
+// path is resolved once per picked value, in the order they were picked.
+func ExpandPathParams(ctx context.Context, api *spec.API, template, path string, cfg *config.Config, c *client.Client) ([]Expanded, error) {
+	return expand(ctx, api, template, path, cfg, c, cfg.Multi)
+}
 
+// partial is a path resolved up to some segment.
+type partial struct {
+	parts    []string
+	resolved map[string]string // values so far, to scope later lookups (e.g. app_id for install listing)
+	params   []Param
+}
+
+func expand(ctx context.Context, api *spec.API, template, path string, cfg *config.Config, c *client.Client, multi bool) ([]Expanded, error) {
 	parts := strings.Split(path, "/")
-	for i, part := range parts {
-		if !strings.HasPrefix(part, "{") || !strings.HasSuffix(part, "}") {
-			continue
-		}
+	templateParts := strings.Split(template, "/")
+	if len(templateParts) != len(parts) {
+		templateParts = parts
+	}
 
-		paramName := part[1 : len(part)-1]
+	fanOut := -1
+	if multi {
+		for i, part := range parts {
+			if isPlaceholder(part) {
+				if v, _ := configuredParam(cfg, part[1:len(part)-1]); v == "" {
+					fanOut = i
+				}
+			}
+		}
+		if fanOut < 0 {
+			return nil, fmt.Errorf("--multi: every path param already has a value; leave one to select")
+		}
+	}
 
-		// 1. Check env var
-		if fn, ok := envMap[paramName]; ok {
-			if val := fn(cfg); val != "" {
-				parts[i] = val
-				resolved[paramName] = val
+	partials := []partial{{parts: parts, resolved: make(map[string]string)}}
+	for i := range parts {
+		var next []partial
+		for _, p := range partials {
+			params, err := resolveSegment(ctx, api, template, p.parts[i], templateParts[i], cfg, c, p.resolved, i == fanOut)
+			if err != nil {
+				return nil, err
+			}
+			if params == nil {
+				next = append(next, p)
 				continue
 			}
+			for _, param := range params {
+				debug.Log("resolve: {%s} = %s (%s)", param.Name, param.Value, param.Source)
+				q := partial{
+					parts:    append([]string(nil), p.parts...),
+					resolved: make(map[string]string, len(p.resolved)+1),
+					params:   append(append([]Param(nil), p.params...), param),
+				}
+				for k, v := range p.resolved {
+					q.resolved[k] = v
+				}
+				q.parts[i] = param.Value
+				q.resolved[param.Name] = param.Value
+				next = append(next, q)
+			}
 		}
+		partials = next
+	}
 
-		// 2. Interactive selection
-		val, err := selectParam(paramName, cfg, c, resolved)
+	expanded := make([]Expanded, len(partials))
+	for i, p := range partials {
+		expanded[i] = Expanded{Path: strings.Join(p.parts, "/"), Params: p.params}
+	}
+	return expanded, nil
+}
+
+// resolveSegment returns the value of the path segment part, or nil if it is
+// not a path parameter. It returns several values when multi is set and the
+// parameter is left to the selector.
+func resolveSegment(ctx context.Context, api *spec.API, template, part, templatePart string, cfg *config.Config, c *client.Client, resolved map[string]string, multi bool) ([]Param, error) {
+	paramName, source, val := "", "", ""
+	switch {
+	case isPlaceholder(part):
+		paramName = part[1 : len(part)-1]
+		// 1. -p flag, env var or saved context
+		val, source = configuredParam(cfg, paramName)
+	case isPlaceholder(templatePart):
+		paramName = templatePart[1 : len(templatePart)-1]
+		val, source = part, "path"
+	default:
+		return nil, nil
+	}
+
+	if val == "" {
+		// 2. Interactive selection (or --last), unless prompting is impossible
+		values, source, err := selectParam(ctx, api, template, paramName, cfg, c, resolved, multi)
 		if err != nil {
-			return "", err
+			return nil, err
+		}
+		params := make([]Param, len(values))
+		for i, v := range values {
+			params[i] = Param{Name: paramName, Value: v, Source: source}
 		}
-		parts[i] = val
-		resolved[paramName] = val
+		return params, nil
 	}
 
-	return strings.Join(parts, "/"), nil
+	id, err := resolveName(ctx, api, template, paramName, val, cfg, c, resolved)
+	if err != nil {
+		return nil, err
+	}
+	if id != val {
+		source += fmt.Sprintf(" (name %q)", strings.TrimPrefix(val, namePrefix))
+	}
+	return []Param{{Name: paramName, Value: id, Source: source}}, nil
 }
 
-func selectParam(paramName string, cfg *config.Config, c *client.Client, resolved map[string]string) (string, error) {
-	listPath, ok := listEndpoints[paramName]
-	if !ok {
-		return "", fmt.Errorf("cannot resolve {%s}: no list endpoint known and no env var set", paramName)
+// configuredParam returns the value for {name} from -p flags, then from
+// NUON_<NAME> (which includes the org, app and install from the nuon CLI
+// config), then from the saved context, along with its source.
+func configuredParam(cfg *config.Config, name string) (string, string) {
+	if v := cfg.Params[name]; v != "" {
+		return v, "flag -p"
 	}
-
-	resp, err := c.Do("GET", listPath, "")
-	if err != nil {
-		return "", fmt.Errorf("fetching resources for {%s}: %w", paramName, err)
+	if fn, ok := envMap[name]; ok {
+		if v := fn(cfg); v != "" {
+			return v, "env " + config.ParamEnvVar(name)
+		}
+	}
+	if cfg.ParamsFromEnv {
+		if v := config.EnvParam(name); v != "" {
+			return v, "env " + config.ParamEnvVar(name)
+		}
+	}
+	if v := cfg.Context[name]; v != "" {
+		return v, "context"
 	}
-	if resp.StatusCode >= 400 {
-		return "", fmt.Errorf("fetching resources for {%s}: HTTP %d", paramName, resp.StatusCode)
+	return "", ""
+}
+
+func isPlaceholder(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// fillTemplate substitutes every placeholder in template using value, and
+// fails if any of them is unknown.
+func fillTemplate(template string, value func(name string) string) (string, bool) {
+	parts := strings.Split(template, "/")
+	for i, part := range parts {
+		if !isPlaceholder(part) {
+			continue
+		}
+		v := value(part[1 : len(part)-1])
+		if v == "" {
+			return "", false
+		}
+		parts[i] = v
 	}
+	return strings.Join(parts, "/"), true
+}
 
-	resources, err := parseResources(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("parsing resources for {%s}: %w", paramName, err)
+// selectParam returns the value picked in the selector for paramName, or the
+// most recent pick with --last, along with its source. With multi, several
+// values may be picked and --last does not apply. Picks are remembered per API
+// host and org and pinned at the top of later selectors.
+func selectParam(ctx context.Context, api *spec.API, template, paramName string, cfg *config.Config, c *client.Client, resolved map[string]string, multi bool) ([]string, string, error) {
+	source, ok := listEndpoint(api, template, paramName, cfg, resolved)
+	store := recentStore(cfg)
+	if cfg.Last && ok && !multi {
+		if choice, found := store.Last(paramName, source.Path); found {
+			return []string{choice.ID}, "recent (--last)", nil
+		}
+		debug.Log("resolve: no recent {%s} picked from %s", paramName, source.Path)
+	}
+	if reason := noInputReason(cfg); reason != "" {
+		return nil, "", unresolvedParam(ctx, paramName, reason, source, ok, c)
 	}
+	if !ok {
+		return nil, "", fmt.Errorf("cannot resolve {%s}: no list endpoint known and no env var set", paramName)
+	}
+	debug.Log("resolve: listing {%s} candidates from %s (id field %s, search %t)", paramName, source.Path, source.IDField, source.Search)
 
-	result, err := selector.Run(paramName, resources)
+	var pinned []selector.Resource
+	for _, choice := range store.Get(paramName) {
+		if choice.Scope == source.Path {
+			pinned = append(pinned, selector.Resource{ID: choice.ID, Name: choice.Name})
+		}
+	}
+	result, err := selector.Run(ctx, paramName, selector.Source{
+		Load:   pageLoader(c, paramName, source),
+		Search: source.Search,
+		Recent: pinned,
+		Multi:  multi,
+	})
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 	if !result.Selected {
-		return "", fmt.Errorf("no selection made for {%s}", paramName)
+		return nil, "", fmt.Errorf("no selection made for {%s}", paramName)
 	}
 
-	return result.ID, nil
+	picked := result.All
+	if len(picked) == 0 {
+		picked = []selector.Resource{{ID: result.ID, Name: result.Name}}
+	}
+	values := make([]string, len(picked))
+	for i, r := range picked {
+		values[i] = r.ID
+	}
+	// Record in reverse so the first pick ends up most recent.
+	for i := len(picked) - 1; i >= 0; i-- {
+		if err := store.Add(paramName, recent.Choice{ID: picked[i].ID, Name: picked[i].Name, Scope: source.Path}); err != nil {
+			debug.Log("resolve: not remembering {%s}: %v", paramName, err)
+		}
+	}
+	if multi {
+		return values, "selector (multi)", nil
+	}
+	return values, "selector", nil
 }
 
-// parseResources extracts id+name from a JSON array of objects.
-func parseResources(data []byte) ([]selector.Resource, error) {
-	var items []map[string]any
-	if err := json.Unmarshal(data, &items); err != nil {
-		return nil, err
+// pageLoader fetches one page of source for the selector, passing the filter
+// text as q to endpoints that search on the server.
+func pageLoader(c *client.Client, paramName string, source listSource) func(context.Context, string, int) (selector.Page, error) {
+	return func(ctx context.Context, query string, offset int) (selector.Page, error) {
+		var q []client.QueryParam
+		if query != "" {
+			q = append(q, client.QueryParam{Key: "q", Value: query})
+		}
+		items, more, err := c.Page(ctx, source.Path, q, offset, selectorPageSize)
+		var statusErr *client.StatusError
+		if errors.As(err, &statusErr) {
+			return selector.Page{}, fmt.Errorf("fetching resources for {%s}: HTTP %d", paramName, statusErr.Response.StatusCode)
+		}
+		if err != nil {
+			return selector.Page{}, fmt.Errorf("fetching resources for {%s}: %w", paramName, err)
+		}
+		return selector.Page{
+			Resources: parseResources(items, source.IDField),
+			Next:      offset + len(items),
+			More:      more,
+		}, nil
 	}
+}
+
+// recentStore holds the recent selections for cfg's API host and org.
+func recentStore(cfg *config.Config) *recent.Store {
+	return recent.New(filepath.Join(cfg.StateDir(), "recent"), cfg.APIURL, cfg.Org())
+}
 
+// parseResources extracts id, name and context columns from a page of JSON
+// objects. idField names the field holding the value to select, "id" unless
+// the listing wraps the resource (install components carry the component's ID
+// in component_id).
+func parseResources(items []json.RawMessage, idField string) []selector.Resource {
 	resources := make([]selector.Resource, 0, len(items))
-	for _, item := range items {
-		id, _ := item["id"].(string)
+	for _, raw := range items {
+		var item map[string]any
+		if err := json.Unmarshal(raw, &item); err != nil {
+			continue
+		}
+
+		id, _ := item[idField].(string)
 		if id == "" {
 			continue
 		}
 
-		// Use the best available display name
-		name := stringField(item, "display_name", "name", "id")
+		// Use the best available display name, preferring the wrapped
+		// resource's (e.g. "component" for component_id).
+		name := ""
+		if idField != "id" {
+			if nested, ok := item[strings.TrimSuffix(idField, "_id")].(map[string]any); ok {
+				name = stringField(nested, "display_name", "name")
+			}
+		}
+		if name == "" {
+			name = stringField(item, "display_name", "name", idField)
+		}
 
 		resources = append(resources, selector.Resource{
-			ID:   id,
-			Name: name,
+			ID:      id,
+			Name:    name,
+			Columns: itemColumns(item),
+			Raw:     redact.JSON(raw),
 		})
 	}
 
-	return resources, nil
+	return resources
 }
 
 func stringField(obj map[string]any, keys ...string) string {
@@ -133,3 +353,13 @@ func stringField(obj map[string]any, keys ...string) string {
 	}
 	return ""
 }
+
+// ResolveName returns the ID of the paramName resource named value, for
+// values given outside a path (e.g. `nuon api context set app=my-app`). Unlike
+// in a path, every value that is not an ID is a name that must match exactly
+// one resource, with or without the @ prefix, so a mistyped name fails instead
+// of being kept as an ID. IDs are 26-character Nuon IDs or values with a type
+// prefix known to prefixes, which may be nil.
+func ResolveName(ctx context.Context, api *spec.API, paramName, value string, prefixes *idprefix.Store, cfg *config.Config, c *client.Client, resolved map[string]string) (string, error) {
+	return lookupName(ctx, api, "", paramName, value, true, prefixes, cfg, c, resolved)
+}
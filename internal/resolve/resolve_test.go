@@ -0,0 +1,323 @@
+package resolve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nuonco/nuon-ext-api/internal/client"
+	"github.com/nuonco/nuon-ext-api/internal/config"
+	"github.com/nuonco/nuon-ext-api/internal/idprefix"
+	"github.com/nuonco/nuon-ext-api/internal/recent"
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+func parseSpec(t *testing.T) *spec.API {
+	t.Helper()
+	api, err := spec.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api
+}
+
+func TestListEndpointIsScopedByResolvedParents(t *testing.T) {
+	api := parseSpec(t)
+	cfg := &config.Config{}
+
+	template := "/v1/apps/{app_id}/components/{component_id}"
+	resolved := map[string]string{"app_id": "app_123"}
+	if got, _ := listEndpoint(api, template, "component_id", cfg, resolved); got.Path != "/v1/apps/app_123/components" {
+		t.Fatalf("expected app-scoped component listing, got %q", got.Path)
+	}
+
+	template = "/v1/installs/{install_id}/deploys/{deploy_id}"
+	resolved = map[string]string{"install_id": "ins_1", "component_id": "cmp_1"}
+	if got, _ := listEndpoint(api, template, "deploy_id", cfg, resolved); got.Path != "/v1/installs/ins_1/components/cmp_1/deploys" {
+		t.Fatalf("expected the most specific deploy listing, got %q", got.Path)
+	}
+
+	if got, _ := listEndpoint(api, "/v1/components/{component_id}", "component_id", cfg, map[string]string{}); got.Path != "/v1/components" {
+		t.Fatalf("expected the global listing without a parent, got %q", got.Path)
+	}
+
+	if got, _ := listEndpoint(api, "/v1/workflows/{workflow_id}", "workflow_id", &config.Config{InstallID: "ins_env"}, map[string]string{}); got.Path != "/v1/installs/ins_env/workflows" {
+		t.Fatalf("expected NUON_INSTALL_ID to scope workflows, got %q", got.Path)
+	}
+}
+
+func TestListEndpointDerivesCollectionsFromSpec(t *testing.T) {
+	api := parseSpec(t)
+	cfg := &config.Config{}
+
+	got, ok := listEndpoint(api, "/v1/workflows/{workflow_id}/steps/{step_id}", "step_id", cfg, map[string]string{"workflow_id": "wf_1"})
+	if !ok || got.Path != "/v1/workflows/wf_1/steps" {
+		t.Fatalf("expected the sibling steps collection, got %q", got.Path)
+	}
+
+	got, _ = listEndpoint(api, "/v1/installs/{install_id}/components/{component_id}", "component_id", cfg, map[string]string{"install_id": "ins_1"})
+	if got.Path != "/v1/installs/ins_1/components" || got.IDField != "component_id" {
+		t.Fatalf("expected install components keyed by component_id, got %+v", got)
+	}
+
+	got, _ = listEndpoint(api, "/v1/apps/{app_id}/components/{component_id}", "component_id", cfg, map[string]string{"app_id": "app_1"})
+	if !got.Search {
+		t.Fatalf("expected app components to support server-side search, got %+v", got)
+	}
+
+	cfg.ListEndpoints = map[string]string{"step_id": "/v1/custom/{workflow_id}"}
+	got, _ = listEndpoint(api, "/v1/workflows/{workflow_id}/steps/{step_id}", "step_id", cfg, map[string]string{"workflow_id": "wf_1"})
+	if got.Path != "/v1/custom/wf_1" {
+		t.Fatalf("expected the configured override, got %q", got.Path)
+	}
+}
+
+func TestParseResourcesUsesIDFieldAndWrappedName(t *testing.T) {
+	items := []json.RawMessage{
+		json.RawMessage(`{"id":"inc_1","component_id":"cmp_1","component":{"name":"api"}}`),
+	}
+
+	got := parseResources(items, "component_id")
+	if len(got) != 1 || got[0].ID != "cmp_1" || got[0].Name != "api" {
+		t.Fatalf("unexpected resources: %+v", got)
+	}
+}
+
+func TestPathParamsResolvesNamesToIDs(t *testing.T) {
+	var installLists int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/installs":
+			installLists++
+			w.Write([]byte(`[]`))
+		case "/v1/apps":
+			w.Write([]byte(`[{"id":"app00000000000000000000001","name":"my-app"},{"id":"app00000000000000000000002","name":"dup"},{"id":"app00000000000000000000003","name":"dup"}]`))
+		case "/v1/apps/app00000000000000000000001/installs":
+			w.Write([]byte(`[{"id":"ins00000000000000000000001","name":"acme-prod"}]`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	}))
+	defer srv.Close()
+
+	api := parseSpec(t)
+	cfg := &config.Config{APIURL: srv.URL}
+	c, err := client.New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	got, _, err := PathParams(ctx, api, "/v1/apps/{app_id}/installs", "/v1/apps/@my-app/installs", cfg, c)
+	if err != nil || got != "/v1/apps/app00000000000000000000001/installs" {
+		t.Fatalf("expected the app name to resolve, got %q, %v", got, err)
+	}
+
+	for _, value := range []string{"ins_123", "acme-dev", "my_install"} {
+		got, _, err = PathParams(ctx, api, "/v1/installs/{install_id}", "/v1/installs/"+value, cfg, c)
+		if err != nil || got != "/v1/installs/"+value || installLists != 0 {
+			t.Fatalf("expected %q to be used as given without a lookup, got %q, %v (%d lookups)", value, got, err, installLists)
+		}
+	}
+
+	cfg.NoNameLookup = true
+	if _, _, err := PathParams(ctx, api, "/v1/apps/{app_id}", "/v1/apps/@my-app", cfg, c); err == nil {
+		t.Fatal("expected @name to fail with NoNameLookup")
+	}
+	cfg.NoNameLookup = false
+
+	if _, _, err := PathParams(ctx, api, "/v1/apps/{app_id}", "/v1/apps/@missing", cfg, c); err == nil {
+		t.Fatal("expected an error for an unknown @name")
+	}
+
+	_, _, err = PathParams(ctx, api, "/v1/apps/{app_id}", "/v1/apps/@dup", cfg, c)
+	var ambiguous *AmbiguousNameError
+	if !errors.As(err, &ambiguous) || len(ambiguous.Candidates) != 2 {
+		t.Fatalf("expected an AmbiguousNameError with 2 candidates, got %v", err)
+	}
+}
+
+func TestPathParamsPrecedenceAndSources(t *testing.T) {
+	const (
+		flagID = "wkfflag0000000000000000000"
+		envID  = "wkfenv00000000000000000000"
+		stepID = "stpenv00000000000000000000"
+		appID  = "apppath0000000000000000000"
+	)
+	t.Setenv("NUON_WORKFLOW_ID", envID)
+	t.Setenv("NUON_STEP_ID", stepID)
+	cfg := &config.Config{
+		Params:        map[string]string{"workflow_id": flagID},
+		ParamsFromEnv: true,
+	}
+
+	got, params, err := PathParams(context.Background(), nil, "/v1/workflows/{workflow_id}/steps/{step_id}", "/v1/workflows/{workflow_id}/steps/{step_id}", cfg, nil)
+	if err != nil {
+		t.Fatalf("PathParams() returned error: %v", err)
+	}
+	if got != "/v1/workflows/"+flagID+"/steps/"+stepID {
+		t.Fatalf("expected -p to win over env, got %q", got)
+	}
+	want := []Param{
+		{Name: "workflow_id", Value: flagID, Source: "flag -p"},
+		{Name: "step_id", Value: stepID, Source: "env NUON_STEP_ID"},
+	}
+	if len(params) != len(want) || params[0] != want[0] || params[1] != want[1] {
+		t.Fatalf("unexpected sources: %+v", params)
+	}
+
+	cfg.Params = map[string]string{"app_id": "appflag0000000000000000000"}
+	_, params, _ = PathParams(context.Background(), nil, "/v1/apps/{app_id}", "/v1/apps/"+appID, cfg, nil)
+	if len(params) != 1 || params[0].Value != appID || params[0].Source != "path" {
+		t.Fatalf("expected the literal path value to win, got %+v", params)
+	}
+}
+
+func TestPathParamsWithoutInputFailsWithCandidates(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":"ins00000000000000000000001","name":"a"},{"id":"ins00000000000000000000002","name":"b"},{"id":"ins00000000000000000000003","name":"c"},{"id":"ins00000000000000000000004","name":"d"},{"id":"ins00000000000000000000005","name":"e"},{"id":"ins00000000000000000000006","name":"f"}]`))
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{APIURL: srv.URL, NoInput: true}
+	c, err := client.New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = PathParams(context.Background(), parseSpec(t), "/v1/installs/{install_id}", "/v1/installs/{install_id}", cfg, c)
+	var unresolved *UnresolvedParamError
+	if !errors.As(err, &unresolved) {
+		t.Fatalf("expected an UnresolvedParamError, got %v", err)
+	}
+	if unresolved.Param != "install_id" || unresolved.EnvVar != "NUON_INSTALL_ID" || unresolved.Flag != "-p install_id=<id>" {
+		t.Fatalf("unexpected hints: %+v", unresolved)
+	}
+	if unresolved.ListPath != "/v1/installs" || len(unresolved.Candidates) != unresolvedCandidates || !unresolved.More {
+		t.Fatalf("expected the first %d candidates from /v1/installs, got %+v", unresolvedCandidates, unresolved)
+	}
+}
+
+func TestPathParamsLastReusesRecentChoice(t *testing.T) {
+	const installID = "ins00000000000000000000001"
+	cfg := &config.Config{APIURL: "https://api.example", OrgID: "org_1", ExtDir: t.TempDir(), Last: true, NoInput: true}
+	recentStore(cfg).Add("install_id", recent.Choice{ID: installID, Scope: "/v1/installs"})
+
+	got, params, err := PathParams(context.Background(), parseSpec(t), "/v1/installs/{install_id}", "/v1/installs/{install_id}", cfg, nil)
+	if err != nil || got != "/v1/installs/"+installID {
+		t.Fatalf("expected the recent install, got %q, %v", got, err)
+	}
+	if params[0].Source != "recent (--last)" {
+		t.Fatalf("unexpected source %q", params[0].Source)
+	}
+
+	cfg.OrgID = "org_2"
+	if _, _, err := PathParams(context.Background(), parseSpec(t), "/v1/installs/{install_id}", "/v1/installs/{install_id}", cfg, nil); err == nil {
+		t.Fatal("expected no recent choice for another org")
+	}
+}
+
+func TestParseResourcesExtractsColumns(t *testing.T) {
+	defer func(orig func() time.Time) { now = orig }(now)
+	now = func() time.Time { return time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC) }
+
+	items := []json.RawMessage{
+		json.RawMessage(`{"id":"ins_1","name":"prod-us","status":"active","cloud_platform":"aws","runner_status":"healthy","updated_at":"2026-03-10T09:00:00Z"}`),
+		json.RawMessage(`{"id":"wfl_1","name":"deploy","status":{"status":"in-progress"},"created_at":"2026-01-01T00:00:00Z"}`),
+	}
+
+	got := parseResources(items, "id")
+	want := [][]string{
+		{"active", "aws", "runner healthy", "updated 3h ago"},
+		{"in-progress", "created 2026-01-01"},
+	}
+	for i, values := range want {
+		if len(got[i].Columns) != len(values) {
+			t.Fatalf("expected columns %v, got %+v", values, got[i].Columns)
+		}
+		for j, v := range values {
+			if got[i].Columns[j].Value != v {
+				t.Fatalf("expected columns %v, got %+v", values, got[i].Columns)
+			}
+		}
+	}
+	if !strings.Contains(string(got[0].Raw), `"prod-us"`) {
+		t.Fatal("expected the raw item to be kept for the preview")
+	}
+}
+
+func TestPathParamsUsesContextAfterEnv(t *testing.T) {
+	const (
+		envApp     = "appenv00000000000000000000"
+		ctxApp     = "appctx00000000000000000000"
+		ctxInstall = "insctx00000000000000000000"
+	)
+	cfg := &config.Config{
+		AppID:   envApp,
+		Context: map[string]string{"app_id": ctxApp, "install_id": ctxInstall},
+	}
+
+	_, params, err := PathParams(context.Background(), nil, "/v1/apps/{app_id}/installs/{install_id}", "/v1/apps/{app_id}/installs/{install_id}", cfg, nil)
+	if err != nil {
+		t.Fatalf("PathParams() returned error: %v", err)
+	}
+	want := []Param{
+		{Name: "app_id", Value: envApp, Source: "env NUON_APP_ID"},
+		{Name: "install_id", Value: ctxInstall, Source: "context"},
+	}
+	if len(params) != 2 || params[0] != want[0] || params[1] != want[1] {
+		t.Fatalf("expected env to win over context, got %+v", params)
+	}
+}
+
+func TestExpandPathParamsMultiNeedsAParamToSelect(t *testing.T) {
+	cfg := &config.Config{InstallID: "ins_123", Multi: true}
+
+	_, err := ExpandPathParams(context.Background(), nil, "/v1/installs/{install_id}", "/v1/installs/{install_id}", cfg, nil)
+	if err == nil || !strings.Contains(err.Error(), "--multi") {
+		t.Fatalf("expected --multi to fail when every param has a value, got %v", err)
+	}
+
+	cfg.Multi = false
+	expanded, err := ExpandPathParams(context.Background(), nil, "/v1/installs/{install_id}", "/v1/installs/{install_id}", cfg, nil)
+	if err != nil || len(expanded) != 1 || expanded[0].Path != "/v1/installs/ins_123" {
+		t.Fatalf("expected one resolved path without --multi, got %+v, %v", expanded, err)
+	}
+}
+
+func TestResolveNameRequiresAMatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":"app00000000000000000000001","name":"my-app"},{"id":"app00000000000000000000002","name":"my_app"}]`))
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{APIURL: srv.URL}
+	c, err := client.New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, api := context.Background(), parseSpec(t)
+
+	prefixes := idprefix.Load(filepath.Join(t.TempDir(), "id-prefixes.json"), map[string][]string{"app_id": {"app"}})
+
+	if id, err := ResolveName(ctx, api, "app_id", "my-app", prefixes, cfg, c, nil); err != nil || id != "app00000000000000000000001" {
+		t.Fatalf("expected the name to resolve, got %q, %v", id, err)
+	}
+	if id, err := ResolveName(ctx, api, "app_id", "my_app", prefixes, cfg, c, nil); err != nil || id != "app00000000000000000000002" {
+		t.Fatalf("expected a name with an unknown prefix to be looked up, got %q, %v", id, err)
+	}
+	if _, err := ResolveName(ctx, api, "app_id", "my-ap", prefixes, cfg, c, nil); err == nil {
+		t.Fatal("expected a name matching nothing to fail")
+	}
+	for _, id := range []string{"app_123", "app00000000000000000000009"} {
+		if got, err := ResolveName(ctx, api, "app_id", id, prefixes, cfg, c, nil); err != nil || got != id {
+			t.Fatalf("expected the ID %q to be kept, got %q, %v", id, got, err)
+		}
+	}
+}
@@ -4,16 +4,20 @@ import "strings"
 
 // Route represents a single API endpoint (one method on one path).
 type Route struct {
-	Path        string  // e.g., "/v1/apps/{app_id}"
-	Method      string  // e.g., "GET"
-	OperationID string  // e.g., "GetApp"
-	Summary     string  // Human-readable description
-	Deprecated  bool    // Whether the endpoint is marked as deprecated in the OpenAPI spec
-	Tag         string  // Primary tag (e.g., "apps")
-	PathParams  []Param // Parameters in the path
-	QueryParams []Param // Query string parameters
-	HasBody     bool    // Whether the endpoint accepts a request body
-	BodySchema  string  // $ref for the body schema (e.g., "#/definitions/service.CreateAppRequest")
+	Path          string   // e.g., "/v1/apps/{app_id}"
+	Method        string   // e.g., "GET"
+	OperationID   string   // e.g., "GetApp"
+	Summary       string   // Human-readable description
+	Deprecated    bool     // Whether the endpoint is marked as deprecated in the OpenAPI spec
+	Tag           string   // Primary tag (e.g., "apps")
+	PathParams    []Param  // Parameters in the path
+	QueryParams   []Param  // Query string parameters
+	HasBody       bool     // Whether the endpoint accepts a request body
+	BodySchema    string   // $ref for the body schema (e.g., "#/definitions/service.CreateAppRequest")
+	Produces      []string // Response content types (e.g., "application/json", "text/csv")
+	Body          *Schema  // Request body schema, if any
+	SuccessStatus int      // Lowest documented 2xx status (e.g., 200, 201)
+	Response      *Schema  // Schema of the success response, if documented
 }
 
 // Param represents a single API parameter.
@@ -60,6 +64,14 @@ func (r Route) MatchesPath(inputPath string) (bool, map[string]string) {
 	return true, params
 }
 
+// Accept returns the Accept header value for this route's response content types.
+func (r Route) Accept() string {
+	if len(r.Produces) == 0 {
+		return "application/json"
+	}
+	return strings.Join(r.Produces, ", ")
+}
+
 // HasUnresolvedParams returns true if the path still contains {param} placeholders.
 func (r Route) HasUnresolvedParams(path string) bool {
 	return strings.Contains(path, "{") && strings.Contains(path, "}")
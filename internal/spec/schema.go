@@ -0,0 +1,65 @@
+package spec
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Schema is the subset of a swagger 2.0 schema object the extension uses
+// for request validation and example generation.
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Enum                 []any              `json:"enum"`
+	Items                *Schema            `json:"items"`
+	Properties           map[string]*Schema `json:"properties"`
+	AdditionalProperties *Schema            `json:"-"`
+	Required             []string           `json:"required"`
+	AllOf                []*Schema          `json:"allOf"`
+	Minimum              *float64           `json:"minimum"`
+	Maximum              *float64           `json:"maximum"`
+	MinLength            *int               `json:"minLength"`
+	MaxLength            *int               `json:"maxLength"`
+	MinItems             *int               `json:"minItems"`
+}
+
+// UnmarshalJSON handles additionalProperties, which swagger allows to be
+// either a schema or a boolean. `true` is treated as an empty (any) schema.
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	type plain Schema
+	var aux struct {
+		*plain
+		AdditionalProperties json.RawMessage `json:"additionalProperties"`
+	}
+	aux.plain = (*plain)(s)
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	switch strings.TrimSpace(string(aux.AdditionalProperties)) {
+	case "", "false", "null":
+	case "true":
+		s.AdditionalProperties = &Schema{}
+	default:
+		s.AdditionalProperties = &Schema{}
+		if err := json.Unmarshal(aux.AdditionalProperties, s.AdditionalProperties); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DefinitionName returns the definition name for a "#/definitions/..." ref.
+func DefinitionName(ref string) string {
+	return strings.TrimPrefix(ref, "#/definitions/")
+}
+
+// Definition resolves a "#/definitions/..." ref. It returns nil for unknown refs.
+func (a *API) Definition(ref string) *Schema {
+	if a.Definitions == nil {
+		return nil
+	}
+	return a.Definitions[DefinitionName(ref)]
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	embeddedSpec "github.com/nuonco/nuon-ext-api/spec"
@@ -11,9 +12,10 @@ import (
 
 // API holds the parsed route table from the swagger spec.
 type API struct {
-	Version string
-	Routes  []Route
-	byPath  map[string][]Route // path template → routes for all methods
+	Version     string
+	Routes      []Route
+	Definitions map[string]*Schema // definition name → schema (e.g., "app.App")
+	byPath      map[string][]Route // path template → routes for all methods
 }
 
 // Parse reads the embedded swagger spec and builds the route table.
@@ -24,8 +26,9 @@ func Parse() (*API, error) {
 	}
 
 	api := &API{
-		Version: raw.Info.Version,
-		byPath:  make(map[string][]Route),
+		Version:     raw.Info.Version,
+		Definitions: raw.Definitions,
+		byPath:      make(map[string][]Route),
 	}
 
 	for path, methods := range raw.Paths {
@@ -41,6 +44,7 @@ func Parse() (*API, error) {
 				OperationID: op.OperationID,
 				Summary:     op.Summary,
 				Deprecated:  op.Deprecated,
+				Produces:    op.Produces,
 			}
 			if len(op.Tags) > 0 {
 				route.Tag = op.Tags[0]
@@ -62,12 +66,15 @@ func Parse() (*API, error) {
 					route.QueryParams = append(route.QueryParams, param)
 				case "body":
 					route.HasBody = true
+					route.Body = p.Schema
 					if p.Schema != nil {
 						route.BodySchema = p.Schema.Ref
 					}
 				}
 			}
 
+			route.SuccessStatus, route.Response = successResponse(op.Responses)
+
 			api.Routes = append(api.Routes, route)
 			api.byPath[path] = append(api.byPath[path], route)
 		}
@@ -148,8 +155,9 @@ func (a *API) LookupByMethod(inputPath, method string) *Route {
 // swagger 2.0 JSON structures — only the fields we need
 
 type swaggerDoc struct {
-	Info  swaggerInfo                     `json:"info"`
-	Paths map[string]map[string]swaggerOp `json:"paths"`
+	Info        swaggerInfo                     `json:"info"`
+	Paths       map[string]map[string]swaggerOp `json:"paths"`
+	Definitions map[string]*Schema              `json:"definitions"`
 }
 
 type swaggerInfo struct {
@@ -157,25 +165,43 @@ type swaggerInfo struct {
 }
 
 type swaggerOp struct {
-	OperationID string         `json:"operationId"`
-	Summary     string         `json:"summary"`
-	Deprecated  bool           `json:"deprecated"`
-	Tags        []string       `json:"tags"`
-	Parameters  []swaggerParam `json:"parameters"`
+	OperationID string                     `json:"operationId"`
+	Summary     string                     `json:"summary"`
+	Deprecated  bool                       `json:"deprecated"`
+	Tags        []string                   `json:"tags"`
+	Parameters  []swaggerParam             `json:"parameters"`
+	Produces    []string                   `json:"produces"`
+	Responses   map[string]swaggerResponse `json:"responses"`
+}
+
+type swaggerResponse struct {
+	Schema *Schema `json:"schema"`
 }
 
 type swaggerParam struct {
-	Name        string         `json:"name"`
-	In          string         `json:"in"`
-	Type        string         `json:"type"`
-	Required    bool           `json:"required"`
-	Description string         `json:"description"`
-	Default     any            `json:"default"`
-	Schema      *swaggerSchema `json:"schema"`
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Type        string  `json:"type"`
+	Required    bool    `json:"required"`
+	Description string  `json:"description"`
+	Default     any     `json:"default"`
+	Schema      *Schema `json:"schema"`
 }
 
-type swaggerSchema struct {
-	Ref string `json:"$ref"`
+// successResponse picks the lowest 2xx status declared for an operation.
+func successResponse(responses map[string]swaggerResponse) (int, *Schema) {
+	status := 0
+	var schema *Schema
+	for code, resp := range responses {
+		n, err := strconv.Atoi(code)
+		if err != nil || n < 200 || n > 299 {
+			continue
+		}
+		if status == 0 || n < status {
+			status, schema = n, resp.Schema
+		}
+	}
+	return status, schema
 }
 
 func isHTTPMethod(m string) bool {
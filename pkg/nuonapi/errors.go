@@ -0,0 +1,64 @@
+package nuonapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNoRoute is returned (wrapped) when a path matches no endpoint in the spec.
+var ErrNoRoute = errors.New("no endpoint found")
+
+// UnresolvedParamError is returned when a {param} placeholder has no value
+// from the request, the Resolver or the client's org/app/install defaults.
+type UnresolvedParamError struct {
+	Param string // placeholder name, e.g. "install_id"
+	Path  string // path as given
+}
+
+func (e *UnresolvedParamError) Error() string {
+	return fmt.Sprintf("cannot resolve {%s} in %s", e.Param, e.Path)
+}
+
+// APIError is returned for responses with status 400 or above.
+type APIError struct {
+	StatusCode      int
+	Message         string // "error" field of the API's error body, if any
+	Description     string // "description" field of the API's error body, if any
+	Body            []byte // raw response body
+	RequestID       string // X-Request-ID sent with the request
+	ServerRequestID string // request/trace ID assigned by the server, if any
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "HTTP %d", e.StatusCode)
+	if e.Message != "" {
+		b.WriteString(": " + e.Message)
+	}
+	if e.Description != "" && e.Description != e.Message {
+		b.WriteString(" (" + e.Description + ")")
+	}
+	if e.RequestID != "" {
+		b.WriteString(" [request id " + e.RequestID + "]")
+	}
+	return b.String()
+}
+
+func newAPIError(resp *Response) *APIError {
+	e := &APIError{
+		StatusCode:      resp.StatusCode,
+		Body:            resp.Body,
+		RequestID:       resp.RequestID,
+		ServerRequestID: resp.ServerRequestID,
+	}
+	var body struct {
+		Error       string `json:"error"`
+		Description string `json:"description"`
+	}
+	if json.Unmarshal(resp.Body, &body) == nil {
+		e.Message, e.Description = body.Error, body.Description
+	}
+	return e
+}
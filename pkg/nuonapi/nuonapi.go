@@ -0,0 +1,218 @@
+package nuonapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/nuonco/nuon-ext-api/internal/client"
+	"github.com/nuonco/nuon-ext-api/internal/config"
+	"github.com/nuonco/nuon-ext-api/internal/dispatch"
+	"github.com/nuonco/nuon-ext-api/internal/spec"
+)
+
+// Client calls the Nuon API using the route table from the embedded spec,
+// the same way `nuon api` does, but never prompts.
+type Client struct {
+	api      *spec.API
+	cfg      *config.Config
+	client   *client.Client
+	resolver Resolver
+}
+
+var (
+	parseOnce sync.Once
+	parsedAPI *spec.API
+	parseErr  error
+)
+
+func loadSpec() (*spec.API, error) {
+	parseOnce.Do(func() { parsedAPI, parseErr = spec.Parse() })
+	return parsedAPI, parseErr
+}
+
+// New creates a Client. Without options it talks to DefaultBaseURL
+// unauthenticated; use FromEnv or WithToken and WithOrgID.
+func New(opts ...Option) (*Client, error) {
+	s := &settings{cfg: &config.Config{APIURL: DefaultBaseURL, Timeout: config.DefaultTimeout}}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	// The library never prompts or looks names up, whatever the terminal or
+	// environment.
+	s.cfg.NoInput = true
+	s.cfg.NoNameLookup = true
+
+	api, err := loadSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	var c *client.Client
+	if s.transport != nil {
+		c = client.NewWithTransport(s.cfg, s.transport)
+	} else if c, err = client.New(s.cfg); err != nil {
+		return nil, err
+	}
+
+	return &Client{api: api, cfg: s.cfg, client: c, resolver: s.resolver}, nil
+}
+
+// Request describes an API call.
+type Request struct {
+	Method string            // inferred from the spec and Body when empty, as on the command line
+	Path   string            // e.g. /v1/apps/{app_id}/components
+	Body   any               // []byte, string, json.RawMessage or a value to encode as JSON
+	Query  url.Values        // query parameters
+	Params map[string]string // values for {param} placeholders in Path
+}
+
+// Response is a successful API response.
+type Response struct {
+	StatusCode      int
+	Header          http.Header
+	Body            []byte
+	RequestID       string // X-Request-ID sent with the request
+	ServerRequestID string // request/trace ID assigned by the server, if any
+}
+
+// Decode unmarshals the JSON body into v.
+func (r *Response) Decode(v any) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// Do resolves req against the spec and sends it. Placeholders are filled from
+// req.Params, then the Resolver, then the client's org/app/install IDs. It
+// returns an *APIError for HTTP errors, an error wrapping ErrNoRoute for
+// unknown paths and an *UnresolvedParamError for missing placeholder values.
+func (c *Client) Do(ctx context.Context, req *Request) (*Response, error) {
+	if len(c.api.Lookup(req.Path)) == 0 {
+		return nil, fmt.Errorf("%w for path: %s", ErrNoRoute, req.Path)
+	}
+
+	path, err := c.fillParams(ctx, req.Path, req.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := encodeBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := dispatch.Resolve(ctx, c.api, path, payload, req.Method, c.cfg, c.client)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Send(ctx, resolved.ClientRequest(queryParams(req.Query)))
+	if err != nil {
+		return nil, err
+	}
+
+	out := &Response{
+		StatusCode:      resp.StatusCode,
+		Header:          resp.Header,
+		Body:            resp.Body,
+		RequestID:       resp.RequestID,
+		ServerRequestID: resp.ServerRequestID,
+	}
+	if resp.StatusCode >= 400 {
+		return nil, newAPIError(out)
+	}
+	return out, nil
+}
+
+// Get sends a GET for path and decodes the JSON response into out, which may
+// be nil. params fills {param} placeholders.
+func (c *Client) Get(ctx context.Context, path string, params map[string]string, out any) error {
+	resp, err := c.Do(ctx, &Request{Method: http.MethodGet, Path: path, Params: params})
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	return resp.Decode(out)
+}
+
+// fillParams replaces every {param} segment in path.
+func (c *Client) fillParams(ctx context.Context, path string, params map[string]string) (string, error) {
+	if !strings.Contains(path, "{") {
+		return path, nil
+	}
+
+	defaults := Params{
+		"org_id":     c.cfg.OrgID,
+		"app_id":     c.cfg.AppID,
+		"install_id": c.cfg.InstallID,
+	}
+	resolved := make(map[string]string)
+
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		if !strings.HasPrefix(part, "{") || !strings.HasSuffix(part, "}") {
+			continue
+		}
+		name := part[1 : len(part)-1]
+
+		val := params[name]
+		if val == "" && c.resolver != nil {
+			var err error
+			if val, err = c.resolver.ResolveParam(ctx, name, resolved); err != nil {
+				return "", fmt.Errorf("resolving {%s}: %w", name, err)
+			}
+		}
+		if val == "" {
+			val = defaults[name]
+		}
+		if val == "" {
+			return "", &UnresolvedParamError{Param: name, Path: path}
+		}
+
+		parts[i] = url.PathEscape(val)
+		resolved[name] = val
+	}
+	return strings.Join(parts, "/"), nil
+}
+
+func encodeBody(body any) (string, error) {
+	switch b := body.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return b, nil
+	case []byte:
+		return string(b), nil
+	case json.RawMessage:
+		return string(b), nil
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return "", fmt.Errorf("encoding request body: %w", err)
+		}
+		return string(data), nil
+	}
+}
+
+func queryParams(q url.Values) []client.QueryParam {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var params []client.QueryParam
+	for _, k := range keys {
+		for _, v := range q[k] {
+			params = append(params, client.QueryParam{Key: k, Value: v})
+		}
+	}
+	return params
+}
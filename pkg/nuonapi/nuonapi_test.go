@@ -0,0 +1,94 @@
+package nuonapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("X-Nuon-Org-ID") != "org_1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/v1/apps/app_1" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"not found","description":"app not found"}`))
+			return
+		}
+		w.Write([]byte(`{"id":"app_1","name":"web"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGetResolvesPlaceholdersFromResolver(t *testing.T) {
+	srv := newTestServer(t)
+	c, err := New(WithBaseURL(srv.URL), WithToken("token"), WithOrgID("org_1"), WithResolver(Params{"app_id": "app_1"}))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	var app struct {
+		Name string `json:"name"`
+	}
+	if err := c.Get(context.Background(), "/v1/apps/{app_id}", nil, &app); err != nil {
+		t.Fatalf("Get() returned error: %v", err)
+	}
+	if app.Name != "web" {
+		t.Fatalf("expected app %q, got %q", "web", app.Name)
+	}
+}
+
+func TestDoReturnsTypedErrors(t *testing.T) {
+	srv := newTestServer(t)
+	c, err := New(WithBaseURL(srv.URL), WithToken("token"), WithOrgID("org_1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	_, err = c.Do(ctx, &Request{Path: "/v1/apps/{app_id}"})
+	var unresolved *UnresolvedParamError
+	if !errors.As(err, &unresolved) || unresolved.Param != "app_id" {
+		t.Fatalf("expected an UnresolvedParamError for app_id, got %v", err)
+	}
+
+	_, err = c.Do(ctx, &Request{Path: "/v1/apps/{app_id}", Params: map[string]string{"app_id": "app_2"}})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 404 || apiErr.Message != "not found" || apiErr.RequestID == "" {
+		t.Fatalf("expected a 404 APIError with a request ID, got %#v", err)
+	}
+
+	if _, err := c.Do(ctx, &Request{Path: "/v1/no-such-endpoint"}); !errors.Is(err, ErrNoRoute) {
+		t.Fatalf("expected ErrNoRoute, got %v", err)
+	}
+}
+
+type countingTransport struct{ calls int }
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestWithTransportIsUsed(t *testing.T) {
+	srv := newTestServer(t)
+	rt := &countingTransport{}
+	c, err := New(WithBaseURL(srv.URL), WithToken("token"), WithOrgID("org_1"), WithTransport(rt))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Get(context.Background(), "/v1/apps/{app_id}", map[string]string{"app_id": "app_1"}, nil); err != nil {
+		t.Fatalf("Get() returned error: %v", err)
+	}
+	if rt.calls != 1 {
+		t.Fatalf("expected 1 request through the custom transport, got %d", rt.calls)
+	}
+}
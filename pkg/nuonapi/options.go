@@ -0,0 +1,63 @@
+package nuonapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/nuonco/nuon-ext-api/internal/config"
+)
+
+// DefaultBaseURL is the public Nuon API.
+const DefaultBaseURL = "https://api.nuon.co"
+
+// Option configures a Client.
+type Option func(*settings)
+
+type settings struct {
+	cfg       *config.Config
+	transport http.RoundTripper
+	resolver  Resolver
+}
+
+// FromEnv loads the same NUON_* environment variables as `nuon api`: URL,
+// token, org, app and install IDs, timeout and transport settings. Options
+// after it override what it loaded.
+func FromEnv() Option {
+	return func(s *settings) {
+		s.cfg = config.Load()
+	}
+}
+
+// WithBaseURL sets the API URL. unix:///path/to.sock is accepted unless a
+// custom transport is set.
+func WithBaseURL(url string) Option {
+	return func(s *settings) { s.cfg.APIURL = url }
+}
+
+// WithToken sets the bearer token sent with every request.
+func WithToken(token string) Option {
+	return func(s *settings) { s.cfg.APIToken = token }
+}
+
+// WithOrgID sets the org every request is made for. It also resolves
+// {org_id} placeholders.
+func WithOrgID(orgID string) Option {
+	return func(s *settings) { s.cfg.OrgID = orgID }
+}
+
+// WithTimeout bounds each HTTP request; zero disables the timeout.
+func WithTimeout(d time.Duration) Option {
+	return func(s *settings) { s.cfg.Timeout = d }
+}
+
+// WithTransport sends requests through rt instead of a transport built from
+// the TLS and proxy settings.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(s *settings) { s.transport = rt }
+}
+
+// WithResolver sets the Resolver consulted for {param} placeholders that
+// are not given in Request.Params.
+func WithResolver(r Resolver) Option {
+	return func(s *settings) { s.resolver = r }
+}
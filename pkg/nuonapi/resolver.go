@@ -0,0 +1,26 @@
+package nuonapi
+
+import "context"
+
+// Resolver supplies values for {param} placeholders. Implementations must not
+// prompt; return "" when the value is unknown. resolved holds the values of
+// placeholders earlier in the path, e.g. app_id when resolving component_id.
+type Resolver interface {
+	ResolveParam(ctx context.Context, name string, resolved map[string]string) (string, error)
+}
+
+// ResolverFunc adapts a function to the Resolver interface.
+type ResolverFunc func(ctx context.Context, name string, resolved map[string]string) (string, error)
+
+// ResolveParam calls f.
+func (f ResolverFunc) ResolveParam(ctx context.Context, name string, resolved map[string]string) (string, error) {
+	return f(ctx, name, resolved)
+}
+
+// Params is a Resolver backed by a fixed set of values.
+type Params map[string]string
+
+// ResolveParam returns the value for name, or "" if there is none.
+func (p Params) ResolveParam(_ context.Context, name string, _ map[string]string) (string, error) {
+	return p[name], nil
+}
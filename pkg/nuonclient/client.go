@@ -0,0 +1,66 @@
+package nuonclient
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+
+	"github.com/nuonco/nuon-ext-api/pkg/nuonapi"
+)
+
+//go:generate go run ../../internal/codegen -o generated.go
+
+// Client has one typed method per API operation. Types and methods are
+// generated from spec/doc.json; requests go through nuonapi.Client, so
+// options, placeholder resolution and errors work the same way.
+type Client struct {
+	api *nuonapi.Client
+}
+
+// New creates a Client with the given nuonapi options.
+func New(opts ...nuonapi.Option) (*Client, error) {
+	api, err := nuonapi.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{api: api}, nil
+}
+
+// do sends one operation and decodes the response into out. Empty path
+// params fall back to the resolver and the org/app/install defaults.
+func (c *Client) do(ctx context.Context, method, path string, params map[string]string, query url.Values, body, out any) error {
+	req := &nuonapi.Request{Method: method, Path: path, Params: params, Query: query}
+	if !isNil(body) {
+		req.Body = body
+	}
+
+	resp, err := c.api.Do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	switch o := out.(type) {
+	case nil:
+		return nil
+	case *[]byte:
+		*o = resp.Body
+		return nil
+	}
+	if len(resp.Body) == 0 {
+		return nil
+	}
+	return resp.Decode(out)
+}
+
+// isNil reports whether v is nil or a nil pointer, slice or map.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
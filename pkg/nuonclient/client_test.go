@@ -0,0 +1,50 @@
+package nuonclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nuonco/nuon-ext-api/pkg/nuonapi"
+)
+
+func TestTypedMethods(t *testing.T) {
+	var created map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v1/apps" && r.URL.Query().Get("limit") == "2":
+			w.Write([]byte(`[{"id":"app_1","name":"web"},{"id":"app_2","name":"api"}]`))
+		case r.Method == "POST" && r.URL.Path == "/v1/apps":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"app_3","name":"new"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(nuonapi.WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	apps, err := c.GetApps(ctx, &GetAppsParams{Limit: 2})
+	if err != nil {
+		t.Fatalf("GetApps() returned error: %v", err)
+	}
+	if len(apps) != 2 || apps[1].Name != "api" {
+		t.Fatalf("unexpected apps: %+v", apps)
+	}
+
+	app, err := c.CreateApp(ctx, &ServiceCreateAppRequest{Name: "new"})
+	if err != nil {
+		t.Fatalf("CreateApp() returned error: %v", err)
+	}
+	if app.ID != "app_3" || created["name"] != "new" {
+		t.Fatalf("unexpected create: sent %v, got %+v", created, app)
+	}
+}
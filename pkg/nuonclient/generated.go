@@ -0,0 +1,8726 @@
+// Code generated by internal/codegen from spec/doc.json. DO NOT EDIT.
+
+package nuonclient
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// AWSCredentialsConfig is github_com_nuonco_nuon_pkg_aws_credentials.Config.
+type AWSCredentialsConfig struct {
+	AssumeRole *CredentialsAssumeRoleConfig `json:"assume_role,omitempty"`
+	// when cache ID is set, these credentials will be reused, up to the duration of the sessionTimeout (or default)
+	CacheID string `json:"cache_id,omitempty"`
+	// If profile is provided, we'll use that profile over the default credentials
+	Profile    string                        `json:"profile,omitempty"`
+	Region     string                        `json:"region,omitempty"`
+	Static     *CredentialsStaticCredentials `json:"static,omitempty"`
+	UseDefault bool                          `json:"use_default,omitempty"`
+}
+
+// AppAWSAccount is app.AWSAccount.
+type AppAWSAccount struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	CreatedByID string `json:"created_by_id,omitempty"`
+	IAMRoleARN  string `json:"iam_role_arn,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Region      string `json:"region,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// AppAWSECRImageConfig is app.AWSECRImageConfig.
+type AppAWSECRImageConfig struct {
+	AWSRegion string `json:"aws_region,omitempty"`
+	// connection to parent model
+	ComponentConfigID   string `json:"component_config_id,omitempty"`
+	ComponentConfigType string `json:"component_config_type,omitempty"`
+	CreatedAt           string `json:"created_at,omitempty"`
+	CreatedByID         string `json:"created_by_id,omitempty"`
+	// actual configuration
+	IAMRoleARN string `json:"iam_role_arn,omitempty"`
+	ID         string `json:"id,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+}
+
+// AppAWSIAMRoleType is app.AWSIAMRoleType.
+type AppAWSIAMRoleType string
+
+const (
+	AppAWSIAMRoleTypeRunnerProvision   AppAWSIAMRoleType = "runner_provision"
+	AppAWSIAMRoleTypeRunnerDeprovision AppAWSIAMRoleType = "runner_deprovision"
+	AppAWSIAMRoleTypeRunnerMaintenance AppAWSIAMRoleType = "runner_maintenance"
+	AppAWSIAMRoleTypeBreakglass        AppAWSIAMRoleType = "breakglass"
+	AppAWSIAMRoleTypeCustom            AppAWSIAMRoleType = "custom"
+	AppAWSIAMRoleTypeRunnerBreakglass  AppAWSIAMRoleType = "runner_breakglass"
+)
+
+// AppAWSStackOutputs is app.AWSStackOutputs.
+type AppAWSStackOutputs struct {
+	AccountID             string            `json:"account_id,omitempty"`
+	BreakGlassRoleArns    map[string]string `json:"break_glass_role_arns,omitempty"`
+	CustomRoleArns        map[string]string `json:"custom_role_arns,omitempty"`
+	DeprovisionIAMRoleARN string            `json:"deprovision_iam_role_arn,omitempty"`
+	InstallInputs         map[string]string `json:"install_inputs,omitempty"`
+	MaintenanceIAMRoleARN string            `json:"maintenance_iam_role_arn,omitempty"`
+	PrivateSubnets        []string          `json:"private_subnets,omitempty"`
+	ProvisionIAMRoleARN   string            `json:"provision_iam_role_arn,omitempty"`
+	PublicSubnets         []string          `json:"public_subnets,omitempty"`
+	Region                string            `json:"region,omitempty"`
+	RunnerIAMRoleARN      string            `json:"runner_iam_role_arn,omitempty"`
+	RunnerSubnet          string            `json:"runner_subnet,omitempty"`
+	VPCID                 string            `json:"vpc_id,omitempty"`
+}
+
+// AppAccount is app.Account.
+type AppAccount struct {
+	AccountType AppAccountType `json:"account_type,omitempty"`
+	CreatedAt   string         `json:"created_at,omitempty"`
+	Email       string         `json:"email,omitempty"`
+	ID          string         `json:"id,omitempty"`
+	// ReadOnly Fields
+	OrgIDs       []string         `json:"org_ids,omitempty"`
+	Permissions  PermissionsSet   `json:"permissions,omitempty"`
+	Roles        []AppRole        `json:"roles,omitempty"`
+	Subject      string           `json:"subject,omitempty"`
+	UpdatedAt    string           `json:"updated_at,omitempty"`
+	UserJourneys []AppUserJourney `json:"user_journeys,omitempty"`
+}
+
+// AppAccountType is app.AccountType.
+type AppAccountType string
+
+const (
+	AppAccountTypeAuth        AppAccountType = "auth"
+	AppAccountTypeAuth0       AppAccountType = "auth0"
+	AppAccountTypeService     AppAccountType = "service"
+	AppAccountTypeCanary      AppAccountType = "canary"
+	AppAccountTypeIntegration AppAccountType = "integration"
+)
+
+// AppActionWorkflow is app.ActionWorkflow.
+type AppActionWorkflow struct {
+	AppID       string                    `json:"app_id,omitempty"`
+	ConfigCount int64                     `json:"config_count,omitempty"`
+	Configs     []AppActionWorkflowConfig `json:"configs,omitempty"`
+	CreatedAt   string                    `json:"created_at,omitempty"`
+	CreatedByID string                    `json:"created_by_id,omitempty"`
+	ID          string                    `json:"id,omitempty"`
+	// metadata
+	Name string `json:"name,omitempty"`
+	// TODO: change to default null after migration & after initial pr
+	Status            string `json:"status,omitempty"`
+	StatusDescription string `json:"status_description,omitempty"`
+	UpdatedAt         string `json:"updated_at,omitempty"`
+}
+
+// AppActionWorkflowConfig is app.ActionWorkflowConfig.
+type AppActionWorkflowConfig struct {
+	ActionWorkflowID       string                        `json:"action_workflow_id,omitempty"`
+	AppConfigID            string                        `json:"app_config_id,omitempty"`
+	AppID                  string                        `json:"app_id,omitempty"`
+	BreakGlassRoleARN      string                        `json:"break_glass_role_arn,omitempty"`
+	ComponentDependencyIDs []string                      `json:"component_dependency_ids,omitempty"`
+	CreatedAt              string                        `json:"created_at,omitempty"`
+	CreatedByID            string                        `json:"created_by_id,omitempty"`
+	ID                     string                        `json:"id,omitempty"`
+	References             []string                      `json:"references,omitempty"`
+	Refs                   []RefsRef                     `json:"refs,omitempty"`
+	Role                   string                        `json:"role,omitempty"`
+	Steps                  []AppActionWorkflowStepConfig `json:"steps,omitempty"`
+	Timeout                int64                         `json:"timeout,omitempty"`
+	// INFO: if adding new associations here, ensure they are added to the batch delete activity
+	Triggers  []AppActionWorkflowTriggerConfig `json:"triggers,omitempty"`
+	UpdatedAt string                           `json:"updated_at,omitempty"`
+}
+
+// AppActionWorkflowStepConfig is app.ActionWorkflowStepConfig.
+type AppActionWorkflowStepConfig struct {
+	ActionWorkflowConfigID string `json:"action_workflow_config_id,omitempty"`
+	// this belongs to an app config id
+	AppConfigID              string                       `json:"app_config_id,omitempty"`
+	AppID                    string                       `json:"app_id,omitempty"`
+	Command                  string                       `json:"command,omitempty"`
+	ConnectedGithubVCSConfig *AppConnectedGithubVCSConfig `json:"connected_github_vcs_config,omitempty"`
+	CreatedAt                string                       `json:"created_at,omitempty"`
+	CreatedByID              string                       `json:"created_by_id,omitempty"`
+	EnvVars                  map[string]string            `json:"env_vars,omitempty"`
+	ID                       string                       `json:"id,omitempty"`
+	Idx                      int64                        `json:"idx,omitempty"`
+	InlineContents           string                       `json:"inline_contents,omitempty"`
+	// metadata
+	Name           string `json:"name,omitempty"`
+	PreviousStepID string `json:"previous_step_id,omitempty"`
+	// all the details needed for a step
+	PublicGitVCSConfig *AppPublicGitVCSConfig `json:"public_git_vcs_config,omitempty"`
+	References         []string               `json:"references,omitempty"`
+	UpdatedAt          string                 `json:"updated_at,omitempty"`
+}
+
+// AppActionWorkflowTriggerConfig is app.ActionWorkflowTriggerConfig.
+type AppActionWorkflowTriggerConfig struct {
+	ActionWorkflowConfigID string `json:"action_workflow_config_id,omitempty"`
+	// this belongs to an app config id
+	AppConfigID  string        `json:"app_config_id,omitempty"`
+	AppID        string        `json:"app_id,omitempty"`
+	Component    *AppComponent `json:"component,omitempty"`
+	ComponentID  string        `json:"component_id,omitempty"`
+	CreatedAt    string        `json:"created_at,omitempty"`
+	CreatedByID  string        `json:"created_by_id,omitempty"`
+	CronSchedule string        `json:"cron_schedule,omitempty"`
+	ID           string        `json:"id,omitempty"`
+	Index        int64         `json:"index,omitempty"`
+	Type         string        `json:"type,omitempty"`
+	UpdatedAt    string        `json:"updated_at,omitempty"`
+}
+
+// AppActionWorkflowTriggerType is app.ActionWorkflowTriggerType.
+type AppActionWorkflowTriggerType string
+
+const (
+	AppActionWorkflowTriggerTypeManual                    AppActionWorkflowTriggerType = "manual"
+	AppActionWorkflowTriggerTypeCron                      AppActionWorkflowTriggerType = "cron"
+	AppActionWorkflowTriggerTypeAdhoc                     AppActionWorkflowTriggerType = "adhoc"
+	AppActionWorkflowTriggerTypePreDeployComponent        AppActionWorkflowTriggerType = "pre-deploy-component"
+	AppActionWorkflowTriggerTypePostDeployComponent       AppActionWorkflowTriggerType = "post-deploy-component"
+	AppActionWorkflowTriggerTypePreTeardownComponent      AppActionWorkflowTriggerType = "pre-teardown-component"
+	AppActionWorkflowTriggerTypePostTeardownComponent     AppActionWorkflowTriggerType = "post-teardown-component"
+	AppActionWorkflowTriggerTypePreSecretsSync            AppActionWorkflowTriggerType = "pre-secrets-sync"
+	AppActionWorkflowTriggerTypePostSecretsSync           AppActionWorkflowTriggerType = "post-secrets-sync"
+	AppActionWorkflowTriggerTypePreProvision              AppActionWorkflowTriggerType = "pre-provision"
+	AppActionWorkflowTriggerTypePostProvision             AppActionWorkflowTriggerType = "post-provision"
+	AppActionWorkflowTriggerTypePreReprovision            AppActionWorkflowTriggerType = "pre-reprovision"
+	AppActionWorkflowTriggerTypePostReprovision           AppActionWorkflowTriggerType = "post-reprovision"
+	AppActionWorkflowTriggerTypePreDeprovision            AppActionWorkflowTriggerType = "pre-deprovision"
+	AppActionWorkflowTriggerTypePostDeprovision           AppActionWorkflowTriggerType = "post-deprovision"
+	AppActionWorkflowTriggerTypePreDeployAllComponents    AppActionWorkflowTriggerType = "pre-deploy-all-components"
+	AppActionWorkflowTriggerTypePostDeployAllComponents   AppActionWorkflowTriggerType = "post-deploy-all-components"
+	AppActionWorkflowTriggerTypePreTeardownAllComponents  AppActionWorkflowTriggerType = "pre-teardown-all-components"
+	AppActionWorkflowTriggerTypePostTeardownAllComponents AppActionWorkflowTriggerType = "post-teardown-all-components"
+	AppActionWorkflowTriggerTypePreDeprovisionSandbox     AppActionWorkflowTriggerType = "pre-deprovision-sandbox"
+	AppActionWorkflowTriggerTypePostDeprovisionSandbox    AppActionWorkflowTriggerType = "post-deprovision-sandbox"
+	AppActionWorkflowTriggerTypePreReprovisionSandbox     AppActionWorkflowTriggerType = "pre-reprovision-sandbox"
+	AppActionWorkflowTriggerTypePostReprovisionSandbox    AppActionWorkflowTriggerType = "post-reprovision-sandbox"
+	AppActionWorkflowTriggerTypePreUpdateInputs           AppActionWorkflowTriggerType = "pre-update-inputs"
+	AppActionWorkflowTriggerTypePostUpdateInputs          AppActionWorkflowTriggerType = "post-update-inputs"
+)
+
+// AppAdHocStepConfig is app.AdHocStepConfig.
+type AppAdHocStepConfig struct {
+	ActionWorkflowConfigID string `json:"action_workflow_config_id,omitempty"`
+	// this belongs to an app config id
+	AppConfigID              string                       `json:"app_config_id,omitempty"`
+	AppID                    string                       `json:"app_id,omitempty"`
+	Command                  string                       `json:"command,omitempty"`
+	ConnectedGithubVCSConfig *AppConnectedGithubVCSConfig `json:"connected_github_vcs_config,omitempty"`
+	CreatedAt                string                       `json:"created_at,omitempty"`
+	CreatedByID              string                       `json:"created_by_id,omitempty"`
+	EnvVars                  map[string]string            `json:"env_vars,omitempty"`
+	ID                       string                       `json:"id,omitempty"`
+	Idx                      int64                        `json:"idx,omitempty"`
+	InlineContents           string                       `json:"inline_contents,omitempty"`
+	// metadata
+	Name           string `json:"name,omitempty"`
+	PreviousStepID string `json:"previous_step_id,omitempty"`
+	// all the details needed for a step
+	PublicGitVCSConfig *AppPublicGitVCSConfig `json:"public_git_vcs_config,omitempty"`
+	References         []string               `json:"references,omitempty"`
+	UpdatedAt          string                 `json:"updated_at,omitempty"`
+}
+
+// AppApp is app.App.
+type AppApp struct {
+	AppConfigs    []AppAppConfig `json:"app_configs,omitempty"`
+	CloudPlatform string         `json:"cloud_platform,omitempty"`
+	// Transient field for config count (not persisted to database)
+	ConfigCount     int64  `json:"config_count,omitempty"`
+	ConfigDirectory string `json:"config_directory,omitempty"`
+	ConfigRepo      string `json:"config_repo,omitempty"`
+	CreatedAt       string `json:"created_at,omitempty"`
+	CreatedByID     string `json:"created_by_id,omitempty"`
+	Description     string `json:"description,omitempty"`
+	DisplayName     string `json:"display_name,omitempty"`
+	ID              string `json:"id,omitempty"`
+	// fields set via after query
+	InputConfig         *AppAppInputConfig      `json:"input_config,omitempty"`
+	Links               map[string]any          `json:"links,omitempty"`
+	Name                string                  `json:"name,omitempty"`
+	NotificationsConfig *AppNotificationsConfig `json:"notifications_config,omitempty"`
+	OrgID               string                  `json:"org_id,omitempty"`
+	RunnerConfig        *AppAppRunnerConfig     `json:"runner_config,omitempty"`
+	RunnerType          string                  `json:"runner_type,omitempty"`
+	SandboxConfig       *AppAppSandboxConfig    `json:"sandbox_config,omitempty"`
+	Status              string                  `json:"status,omitempty"`
+	StatusDescription   string                  `json:"status_description,omitempty"`
+	UpdatedAt           string                  `json:"updated_at,omitempty"`
+}
+
+// AppAppAWSIAMPolicyConfig is app.AppAWSIAMPolicyConfig.
+type AppAppAWSIAMPolicyConfig struct {
+	AppAWSIAMRoleConfigID   string `json:"app_aws_iam_role_config_id,omitempty"`
+	AppConfigID             string `json:"app_config_id,omitempty"`
+	CloudformationStackName string `json:"cloudformation_stack_name,omitempty"`
+	Contents                string `json:"contents,omitempty"`
+	CreatedAt               string `json:"created_at,omitempty"`
+	CreatedByID             string `json:"created_by_id,omitempty"`
+	ID                      string `json:"id,omitempty"`
+	ManagedPolicyName       string `json:"managed_policy_name,omitempty"`
+	Name                    string `json:"name,omitempty"`
+	OrgID                   string `json:"org_id,omitempty"`
+	UpdatedAt               string `json:"updated_at,omitempty"`
+}
+
+// AppAppAWSIAMRoleConfig is app.AppAWSIAMRoleConfig.
+type AppAppAWSIAMRoleConfig struct {
+	AppConfigID             string                     `json:"app_config_id,omitempty"`
+	CloudformationParamName string                     `json:"cloudformation_param_name,omitempty"`
+	CloudformationStackName string                     `json:"cloudformation_stack_name,omitempty"`
+	CreatedAt               string                     `json:"created_at,omitempty"`
+	CreatedByID             string                     `json:"created_by_id,omitempty"`
+	Description             string                     `json:"description,omitempty"`
+	DisplayName             string                     `json:"display_name,omitempty"`
+	ID                      string                     `json:"id,omitempty"`
+	Name                    string                     `json:"name,omitempty"`
+	OrgID                   string                     `json:"org_id,omitempty"`
+	OwnerID                 string                     `json:"owner_id,omitempty"`
+	OwnerType               string                     `json:"owner_type,omitempty"`
+	PermissionsBoundary     string                     `json:"permissions_boundary,omitempty"`
+	Policies                []AppAppAWSIAMPolicyConfig `json:"policies,omitempty"`
+	Type                    AppAWSIAMRoleType          `json:"type,omitempty"`
+	UpdatedAt               string                     `json:"updated_at,omitempty"`
+}
+
+// AppAppBranch is app.AppBranch.
+type AppAppBranch struct {
+	AppID                      string        `json:"app_id,omitempty"`
+	ConnectedGithubVCSConfigID string        `json:"connected_github_vcs_config_id,omitempty"`
+	CreatedAt                  string        `json:"created_at,omitempty"`
+	CreatedByID                string        `json:"created_by_id,omitempty"`
+	ID                         string        `json:"id,omitempty"`
+	Name                       string        `json:"name,omitempty"`
+	OrgID                      string        `json:"org_id,omitempty"`
+	UpdatedAt                  string        `json:"updated_at,omitempty"`
+	Workflows                  []AppWorkflow `json:"workflows,omitempty"`
+}
+
+// AppAppBreakGlassConfig is app.AppBreakGlassConfig.
+type AppAppBreakGlassConfig struct {
+	AppConfigID string                   `json:"app_config_id,omitempty"`
+	AppID       string                   `json:"app_id,omitempty"`
+	AWSIAMRoles []AppAppAWSIAMRoleConfig `json:"aws_iam_roles,omitempty"`
+	CreatedAt   string                   `json:"created_at,omitempty"`
+	CreatedByID string                   `json:"created_by_id,omitempty"`
+	ID          string                   `json:"id,omitempty"`
+	OrgID       string                   `json:"org_id,omitempty"`
+	UpdatedAt   string                   `json:"updated_at,omitempty"`
+}
+
+// AppAppConfig is app.AppConfig.
+type AppAppConfig struct {
+	ActionWorkflowConfigs      []AppActionWorkflowConfig      `json:"action_workflow_configs,omitempty"`
+	AppBranch                  *AppAppBranch                  `json:"app_branch,omitempty"`
+	AppBranchID                string                         `json:"app_branch_id,omitempty"`
+	AppID                      string                         `json:"app_id,omitempty"`
+	BreakGlass                 *AppAppBreakGlassConfig        `json:"break_glass,omitempty"`
+	Checksum                   string                         `json:"checksum,omitempty"`
+	CliVersion                 string                         `json:"cli_version,omitempty"`
+	ComponentConfigConnections []AppComponentConfigConnection `json:"component_config_connections,omitempty"`
+	ComponentIDs               []string                       `json:"component_ids,omitempty"`
+	CreatedAt                  string                         `json:"created_at,omitempty"`
+	CreatedByID                string                         `json:"created_by_id,omitempty"`
+	ID                         string                         `json:"id,omitempty"`
+	Input                      *AppAppInputConfig             `json:"input,omitempty"`
+	OperationRoleConfig        *AppAppOperationRoleConfig     `json:"operation_role_config,omitempty"`
+	OrgID                      string                         `json:"org_id,omitempty"`
+	Permissions                *AppAppPermissionsConfig       `json:"permissions,omitempty"`
+	Policies                   *AppAppPoliciesConfig          `json:"policies,omitempty"`
+	Readme                     string                         `json:"readme,omitempty"`
+	Runner                     *AppAppRunnerConfig            `json:"runner,omitempty"`
+	Sandbox                    *AppAppSandboxConfig           `json:"sandbox,omitempty"`
+	Secrets                    *AppAppSecretsConfig           `json:"secrets,omitempty"`
+	Stack                      *AppAppStackConfig             `json:"stack,omitempty"`
+	State                      string                         `json:"state,omitempty"`
+	Status                     AppAppConfigStatus             `json:"status,omitempty"`
+	StatusDescription          string                         `json:"status_description,omitempty"`
+	UpdatedAt                  string                         `json:"updated_at,omitempty"`
+	VCSConnectionCommit        *AppVCSConnectionCommit        `json:"vcs_connection_commit,omitempty"`
+	// fields that are filled in via after query or views
+	Version int64 `json:"version,omitempty"`
+}
+
+// AppAppConfigStatus is app.AppConfigStatus.
+type AppAppConfigStatus string
+
+const (
+	AppAppConfigStatusActive   AppAppConfigStatus = "active"
+	AppAppConfigStatusPending  AppAppConfigStatus = "pending"
+	AppAppConfigStatusSyncing  AppAppConfigStatus = "syncing"
+	AppAppConfigStatusError    AppAppConfigStatus = "error"
+	AppAppConfigStatusOutdated AppAppConfigStatus = "outdated"
+)
+
+// AppAppConfigVersion is app.AppConfigVersion.
+type AppAppConfigVersion string
+
+const (
+	AppAppConfigVersionV2 AppAppConfigVersion = "v2"
+)
+
+// AppAppInput is app.AppInput.
+type AppAppInput struct {
+	AppInputID string `json:"app_input_id,omitempty"`
+	// CloudFormation configuration (computed fields, not stored in DB)
+	CloudformationStackName          string            `json:"cloudformation_stack_name,omitempty"`
+	CloudformationStackParameterName string            `json:"cloudformation_stack_parameter_name,omitempty"`
+	CreatedAt                        string            `json:"created_at,omitempty"`
+	CreatedByID                      string            `json:"created_by_id,omitempty"`
+	Default                          string            `json:"default,omitempty"`
+	Description                      string            `json:"description,omitempty"`
+	DisplayName                      string            `json:"display_name,omitempty"`
+	Group                            *AppAppInputGroup `json:"group,omitempty"`
+	GroupID                          string            `json:"group_id,omitempty"`
+	ID                               string            `json:"id,omitempty"`
+	Index                            int64             `json:"index,omitempty"`
+	Internal                         bool              `json:"internal,omitempty"`
+	Name                             string            `json:"name,omitempty"`
+	OrgID                            string            `json:"org_id,omitempty"`
+	Required                         bool              `json:"required,omitempty"`
+	Sensitive                        bool              `json:"sensitive,omitempty"`
+	Source                           string            `json:"source,omitempty"`
+	Type                             string            `json:"type,omitempty"`
+	UpdatedAt                        string            `json:"updated_at,omitempty"`
+}
+
+// AppAppInputConfig is app.AppInputConfig.
+type AppAppInputConfig struct {
+	AppConfigID   string             `json:"app_config_id,omitempty"`
+	AppID         string             `json:"app_id,omitempty"`
+	CreatedAt     string             `json:"created_at,omitempty"`
+	CreatedByID   string             `json:"created_by_id,omitempty"`
+	ID            string             `json:"id,omitempty"`
+	InputGroups   []AppAppInputGroup `json:"input_groups,omitempty"`
+	Inputs        []AppAppInput      `json:"inputs,omitempty"`
+	InstallInputs []AppInstallInputs `json:"install_inputs,omitempty"`
+	OrgID         string             `json:"org_id,omitempty"`
+	UpdatedAt     string             `json:"updated_at,omitempty"`
+}
+
+// AppAppInputGroup is app.AppInputGroup.
+type AppAppInputGroup struct {
+	AppInputID  string        `json:"app_input_id,omitempty"`
+	AppInputs   []AppAppInput `json:"app_inputs,omitempty"`
+	CreatedAt   string        `json:"created_at,omitempty"`
+	CreatedByID string        `json:"created_by_id,omitempty"`
+	Description string        `json:"description,omitempty"`
+	DisplayName string        `json:"display_name,omitempty"`
+	ID          string        `json:"id,omitempty"`
+	Index       int64         `json:"index,omitempty"`
+	Name        string        `json:"name,omitempty"`
+	OrgID       string        `json:"org_id,omitempty"`
+	UpdatedAt   string        `json:"updated_at,omitempty"`
+}
+
+// AppAppInputSource is app.AppInputSource.
+type AppAppInputSource string
+
+const (
+	AppAppInputSourceVendor   AppAppInputSource = "vendor"
+	AppAppInputSourceCustomer AppAppInputSource = "customer"
+)
+
+// AppAppOperationRoleConfig is app.AppOperationRoleConfig.
+type AppAppOperationRoleConfig struct {
+	AppConfigID string                    `json:"app_config_id,omitempty"`
+	AppID       string                    `json:"app_id,omitempty"`
+	CreatedAt   string                    `json:"created_at,omitempty"`
+	CreatedByID string                    `json:"created_by_id,omitempty"`
+	ID          string                    `json:"id,omitempty"`
+	OrgID       string                    `json:"org_id,omitempty"`
+	Rules       []AppAppOperationRoleRule `json:"rules,omitempty"`
+	UpdatedAt   string                    `json:"updated_at,omitempty"`
+}
+
+// AppAppOperationRoleRule is app.AppOperationRoleRule.
+type AppAppOperationRoleRule struct {
+	AppOperationRoleConfigID string  `json:"app_operation_role_config_id,omitempty"`
+	CreatedAt                string  `json:"created_at,omitempty"`
+	CreatedByID              string  `json:"created_by_id,omitempty"`
+	ID                       string  `json:"id,omitempty"`
+	Operation                string  `json:"operation,omitempty"`
+	Org                      *AppOrg `json:"org,omitempty"`
+	OrgID                    string  `json:"org_id,omitempty"`
+	PrincipalName            string  `json:"principal_name,omitempty"`
+	PrincipalType            string  `json:"principal_type,omitempty"`
+	Role                     string  `json:"role,omitempty"`
+	UpdatedAt                string  `json:"updated_at,omitempty"`
+}
+
+// AppAppPermissionsConfig is app.AppPermissionsConfig.
+type AppAppPermissionsConfig struct {
+	AppConfigID           string                   `json:"app_config_id,omitempty"`
+	AppID                 string                   `json:"app_id,omitempty"`
+	AWSIAMRoles           []AppAppAWSIAMRoleConfig `json:"aws_iam_roles,omitempty"`
+	BreakGlassAWSIAMRole  *AppAppAWSIAMRoleConfig  `json:"break_glass_aws_iam_role,omitempty"`
+	CreatedAt             string                   `json:"created_at,omitempty"`
+	CreatedByID           string                   `json:"created_by_id,omitempty"`
+	CustomAWSIAMRoles     []AppAppAWSIAMRoleConfig `json:"custom_aws_iam_roles,omitempty"`
+	DeprovisionAWSIAMRole *AppAppAWSIAMRoleConfig  `json:"deprovision_aws_iam_role,omitempty"`
+	ID                    string                   `json:"id,omitempty"`
+	MaintenanceAWSIAMRole *AppAppAWSIAMRoleConfig  `json:"maintenance_aws_iam_role,omitempty"`
+	OrgID                 string                   `json:"org_id,omitempty"`
+	// loaded via an after query
+	ProvisionAWSIAMRole *AppAppAWSIAMRoleConfig `json:"provision_aws_iam_role,omitempty"`
+	UpdatedAt           string                  `json:"updated_at,omitempty"`
+}
+
+// AppAppPoliciesConfig is app.AppPoliciesConfig.
+type AppAppPoliciesConfig struct {
+	AppConfigID string               `json:"app_config_id,omitempty"`
+	AppID       string               `json:"app_id,omitempty"`
+	CreatedAt   string               `json:"created_at,omitempty"`
+	CreatedByID string               `json:"created_by_id,omitempty"`
+	ID          string               `json:"id,omitempty"`
+	OrgID       string               `json:"org_id,omitempty"`
+	Policies    []AppAppPolicyConfig `json:"policies,omitempty"`
+	UpdatedAt   string               `json:"updated_at,omitempty"`
+}
+
+// AppAppPolicyConfig is app.AppPolicyConfig.
+type AppAppPolicyConfig struct {
+	AppConfigID       string                `json:"app_config_id,omitempty"`
+	AppID             string                `json:"app_id,omitempty"`
+	AppPoliciesConfig string                `json:"app_policies_config,omitempty"`
+	Components        []string              `json:"components,omitempty"`
+	Contents          string                `json:"contents,omitempty"`
+	CreatedAt         string                `json:"created_at,omitempty"`
+	CreatedByID       string                `json:"created_by_id,omitempty"`
+	Description       string                `json:"description,omitempty"`
+	Engine            ConfigAppPolicyEngine `json:"engine,omitempty"`
+	ID                string                `json:"id,omitempty"`
+	Name              string                `json:"name,omitempty"`
+	OrgID             string                `json:"org_id,omitempty"`
+	Type              ConfigAppPolicyType   `json:"type,omitempty"`
+	UpdatedAt         string                `json:"updated_at,omitempty"`
+}
+
+// AppAppRunnerConfig is app.AppRunnerConfig.
+type AppAppRunnerConfig struct {
+	AppConfigID   string            `json:"app_config_id,omitempty"`
+	AppID         string            `json:"app_id,omitempty"`
+	AppRunnerType AppAppRunnerType  `json:"app_runner_type,omitempty"`
+	CloudPlatform AppCloudPlatform  `json:"cloud_platform,omitempty"`
+	CreatedAt     string            `json:"created_at,omitempty"`
+	CreatedByID   string            `json:"created_by_id,omitempty"`
+	EnvVars       map[string]string `json:"env_vars,omitempty"`
+	HelmDriver    string            `json:"helm_driver,omitempty"`
+	ID            string            `json:"id,omitempty"`
+	// takes a URL to a bash script ⤵  which will be `curl | bash`-ed on the VM. usually via user-data or equivalent.
+	InitScript string `json:"init_script,omitempty"`
+	OrgID      string `json:"org_id,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+}
+
+// AppAppRunnerConfigHelmDriverType is app.AppRunnerConfigHelmDriverType.
+type AppAppRunnerConfigHelmDriverType string
+
+const (
+	AppAppRunnerConfigHelmDriverTypeSecret    AppAppRunnerConfigHelmDriverType = "secret"
+	AppAppRunnerConfigHelmDriverTypeConfigmap AppAppRunnerConfigHelmDriverType = "configmap"
+)
+
+// AppAppRunnerType is app.AppRunnerType.
+type AppAppRunnerType string
+
+const (
+	AppAppRunnerTypeUnknown  AppAppRunnerType = "unknown"
+	AppAppRunnerTypeAWSEcs   AppAppRunnerType = "aws-ecs"
+	AppAppRunnerTypeAWSEks   AppAppRunnerType = "aws-eks"
+	AppAppRunnerTypeAzureAks AppAppRunnerType = "azure-aks"
+	AppAppRunnerTypeAzureAcs AppAppRunnerType = "azure-acs"
+	AppAppRunnerTypeLocal    AppAppRunnerType = "local"
+	AppAppRunnerTypeAWS      AppAppRunnerType = "aws"
+	AppAppRunnerTypeAzure    AppAppRunnerType = "azure"
+)
+
+// AppAppSandboxConfig is app.AppSandboxConfig.
+type AppAppSandboxConfig struct {
+	AppConfigID string `json:"app_config_id,omitempty"`
+	AppID       string `json:"app_id,omitempty"`
+	// cloud specific fields
+	AWSRegionType string `json:"aws_region_type,omitempty"`
+	// fields set via after query
+	CloudPlatform            string                       `json:"cloud_platform,omitempty"`
+	ConnectedGithubVCSConfig *AppConnectedGithubVCSConfig `json:"connected_github_vcs_config,omitempty"`
+	CreatedAt                string                       `json:"created_at,omitempty"`
+	CreatedByID              string                       `json:"created_by_id,omitempty"`
+	DriftSchedule            string                       `json:"drift_schedule,omitempty"`
+	EnvVars                  map[string]string            `json:"env_vars,omitempty"`
+	ID                       string                       `json:"id,omitempty"`
+	// Operation roles map: operation type -> role name
+	OperationRoles     map[string]string      `json:"operation_roles,omitempty"`
+	OrgID              string                 `json:"org_id,omitempty"`
+	PublicGitVCSConfig *AppPublicGitVCSConfig `json:"public_git_vcs_config,omitempty"`
+	References         []string               `json:"references,omitempty"`
+	Refs               []RefsRef              `json:"refs,omitempty"`
+	TerraformVersion   string                 `json:"terraform_version,omitempty"`
+	UpdatedAt          string                 `json:"updated_at,omitempty"`
+	Variables          map[string]string      `json:"variables,omitempty"`
+	VariablesFiles     []string               `json:"variables_files,omitempty"`
+}
+
+// AppAppSecret is app.AppSecret.
+type AppAppSecret struct {
+	AppID       string `json:"app_id,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	CreatedByID string `json:"created_by_id,omitempty"`
+	ID          string `json:"id,omitempty"`
+	// after query fields
+	Length    int64  `json:"length,omitempty"`
+	Name      string `json:"name,omitempty"`
+	OrgID     string `json:"org_id,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// AppAppSecretConfig is app.AppSecretConfig.
+type AppAppSecretConfig struct {
+	AppConfigID               string `json:"app_config_id,omitempty"`
+	AppID                     string `json:"app_id,omitempty"`
+	AppSecretsConfigID        string `json:"app_secrets_config_id,omitempty"`
+	AutoGenerate              bool   `json:"auto_generate,omitempty"`
+	CloudformationParamName   string `json:"cloudformation_param_name,omitempty"`
+	CloudformationStackName   string `json:"cloudformation_stack_name,omitempty"`
+	CreatedAt                 string `json:"created_at,omitempty"`
+	CreatedByID               string `json:"created_by_id,omitempty"`
+	Default                   string `json:"default,omitempty"`
+	Description               string `json:"description,omitempty"`
+	DisplayName               string `json:"display_name,omitempty"`
+	Format                    string `json:"format,omitempty"`
+	ID                        string `json:"id,omitempty"`
+	KubernetesSecretName      string `json:"kubernetes_secret_name,omitempty"`
+	KubernetesSecretNamespace string `json:"kubernetes_secret_namespace,omitempty"`
+	// for syncing into kubernetes
+	KubernetesSync bool   `json:"kubernetes_sync,omitempty"`
+	Name           string `json:"name,omitempty"`
+	OrgID          string `json:"org_id,omitempty"`
+	Required       bool   `json:"required,omitempty"`
+	UpdatedAt      string `json:"updated_at,omitempty"`
+}
+
+// AppAppSecretsConfig is app.AppSecretsConfig.
+type AppAppSecretsConfig struct {
+	AppConfigID string               `json:"app_config_id,omitempty"`
+	AppID       string               `json:"app_id,omitempty"`
+	CreatedAt   string               `json:"created_at,omitempty"`
+	CreatedByID string               `json:"created_by_id,omitempty"`
+	ID          string               `json:"id,omitempty"`
+	OrgID       string               `json:"org_id,omitempty"`
+	Secrets     []AppAppSecretConfig `json:"secrets,omitempty"`
+	UpdatedAt   string               `json:"updated_at,omitempty"`
+}
+
+// AppAppStackConfig is app.AppStackConfig.
+type AppAppStackConfig struct {
+	AppConfigID             string                    `json:"app_config_id,omitempty"`
+	AppID                   string                    `json:"app_id,omitempty"`
+	CreatedAt               string                    `json:"created_at,omitempty"`
+	CreatedByID             string                    `json:"created_by_id,omitempty"`
+	CustomNestedStacks      []ConfigCustomNestedStack `json:"custom_nested_stacks,omitempty"`
+	Description             string                    `json:"description,omitempty"`
+	ID                      string                    `json:"id,omitempty"`
+	Name                    string                    `json:"name,omitempty"`
+	OrgID                   string                    `json:"org_id,omitempty"`
+	RunnerNestedTemplateURL string                    `json:"runner_nested_template_url,omitempty"`
+	Type                    AppStackType              `json:"type,omitempty"`
+	UpdatedAt               string                    `json:"updated_at,omitempty"`
+	VPCNestedTemplateURL    string                    `json:"vpc_nested_template_url,omitempty"`
+}
+
+// AppAzureAccount is app.AzureAccount.
+type AppAzureAccount struct {
+	CreatedAt                string `json:"created_at,omitempty"`
+	CreatedByID              string `json:"created_by_id,omitempty"`
+	ID                       string `json:"id,omitempty"`
+	Location                 string `json:"location,omitempty"`
+	ServicePrincipalAppID    string `json:"service_principal_app_id,omitempty"`
+	ServicePrincipalPassword string `json:"service_principal_password,omitempty"`
+	SubscriptionID           string `json:"subscription_id,omitempty"`
+	SubscriptionTenantID     string `json:"subscription_tenant_id,omitempty"`
+	UpdatedAt                string `json:"updated_at,omitempty"`
+}
+
+// AppAzureStackOutputs is app.AzureStackOutputs.
+type AppAzureStackOutputs struct {
+	KeyVaultID            string   `json:"key_vault_id,omitempty"`
+	KeyVaultName          string   `json:"key_vault_name,omitempty"`
+	NetworkID             string   `json:"network_id,omitempty"`
+	NetworkName           string   `json:"network_name,omitempty"`
+	PrivateSubnetIDs      []string `json:"private_subnet_ids,omitempty"`
+	PrivateSubnetNames    []string `json:"private_subnet_names,omitempty"`
+	PublicSubnetIDs       []string `json:"public_subnet_ids,omitempty"`
+	PublicSubnetNames     []string `json:"public_subnet_names,omitempty"`
+	ResourceGroupID       string   `json:"resource_group_id,omitempty"`
+	ResourceGroupLocation string   `json:"resource_group_location,omitempty"`
+	ResourceGroupName     string   `json:"resource_group_name,omitempty"`
+	SubscriptionID        string   `json:"subscription_id,omitempty"`
+	SubscriptionTenantID  string   `json:"subscription_tenant_id,omitempty"`
+}
+
+// AppCloudPlatform is app.CloudPlatform.
+type AppCloudPlatform string
+
+const (
+	AppCloudPlatformAWS     AppCloudPlatform = "aws"
+	AppCloudPlatformAzure   AppCloudPlatform = "azure"
+	AppCloudPlatformUnknown AppCloudPlatform = "unknown"
+)
+
+// AppCloudPlatformRegion is app.CloudPlatformRegion.
+type AppCloudPlatformRegion struct {
+	DisplayName string `json:"display_name,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Value       string `json:"value,omitempty"`
+}
+
+// AppComponent is app.Component.
+type AppComponent struct {
+	AppID             string             `json:"app_id,omitempty"`
+	ConfigVersions    int64              `json:"config_versions,omitempty"`
+	CreatedAt         string             `json:"created_at,omitempty"`
+	CreatedByID       string             `json:"created_by_id,omitempty"`
+	Dependencies      []string           `json:"dependencies,omitempty"`
+	ID                string             `json:"id,omitempty"`
+	LatestBuild       *AppComponentBuild `json:"latest_build,omitempty"`
+	Links             map[string]any     `json:"links,omitempty"`
+	Name              string             `json:"name,omitempty"`
+	ResolvedVarName   string             `json:"resolved_var_name,omitempty"`
+	Status            string             `json:"status,omitempty"`
+	StatusDescription string             `json:"status_description,omitempty"`
+	Type              AppComponentType   `json:"type,omitempty"`
+	UpdatedAt         string             `json:"updated_at,omitempty"`
+	VarName           string             `json:"var_name,omitempty"`
+}
+
+// AppComponentBuild is app.ComponentBuild.
+type AppComponentBuild struct {
+	// checksum of our intermediate component config
+	Checksum                  string                        `json:"checksum,omitempty"`
+	ComponentConfigConnection *AppComponentConfigConnection `json:"component_config_connection,omitempty"`
+	// DEPRECATED: will retain the field to connect against the last component config connection that set this build
+	ComponentConfigConnectionID string `json:"component_config_connection_id,omitempty"`
+	ComponentConfigVersion      int64  `json:"component_config_version,omitempty"`
+	// Read-only fields set on the object to de-nest data
+	ComponentID    string                `json:"component_id,omitempty"`
+	ComponentName  string                `json:"component_name,omitempty"`
+	CreatedAt      string                `json:"created_at,omitempty"`
+	CreatedBy      *AppAccount           `json:"created_by,omitempty"`
+	CreatedByID    string                `json:"created_by_id,omitempty"`
+	GitRef         string                `json:"git_ref,omitempty"`
+	ID             string                `json:"id,omitempty"`
+	InstallDeploys []AppInstallDeploy    `json:"install_deploys,omitempty"`
+	LogStream      *AppLogStream         `json:"log_stream,omitempty"`
+	PolicyReports  []AppPolicyReport     `json:"policy_reports,omitempty"`
+	Releases       []AppComponentRelease `json:"releases,omitempty"`
+	// runner details
+	RunnerJob           *AppRunnerJob           `json:"runner_job,omitempty"`
+	Status              string                  `json:"status,omitempty"`
+	StatusDescription   string                  `json:"status_description,omitempty"`
+	StatusV2            *AppCompositeStatus     `json:"status_v2,omitempty"`
+	UpdatedAt           string                  `json:"updated_at,omitempty"`
+	VCSConnectionCommit *AppVCSConnectionCommit `json:"vcs_connection_commit,omitempty"`
+}
+
+// AppComponentConfigConnection is app.ComponentConfigConnection.
+type AppComponentConfigConnection struct {
+	AppConfigID      string `json:"app_config_id,omitempty"`
+	AppConfigVersion int64  `json:"app_config_version,omitempty"`
+	// Duration string for build operations (e.g., "30m", "1h"). Max 1h.
+	BuildTimeout           string   `json:"build_timeout,omitempty"`
+	Checksum               string   `json:"checksum,omitempty"`
+	ComponentDependencyIDs []string `json:"component_dependency_ids,omitempty"`
+	ComponentID            string   `json:"component_id,omitempty"`
+	ComponentName          string   `json:"component_name,omitempty"`
+	CreatedAt              string   `json:"created_at,omitempty"`
+	CreatedByID            string   `json:"created_by_id,omitempty"`
+	// Duration string for deploy operations (e.g., "30m", "1h"). Max 1h.
+	DeployTimeout      string                                `json:"deploy_timeout,omitempty"`
+	DockerBuild        *AppDockerBuildComponentConfig        `json:"docker_build,omitempty"`
+	DriftSchedule      string                                `json:"drift_schedule,omitempty"`
+	ExternalImage      *AppExternalImageComponentConfig      `json:"external_image,omitempty"`
+	Helm               *AppHelmComponentConfig               `json:"helm,omitempty"`
+	ID                 string                                `json:"id,omitempty"`
+	Job                *AppJobComponentConfig                `json:"job,omitempty"`
+	KubernetesManifest *AppKubernetesManifestComponentConfig `json:"kubernetes_manifest,omitempty"`
+	// Operation roles map: operation type -> role name
+	OperationRoles  map[string]string                  `json:"operation_roles,omitempty"`
+	References      []string                           `json:"references,omitempty"`
+	Refs            []RefsRef                          `json:"refs,omitempty"`
+	TerraformModule *AppTerraformModuleComponentConfig `json:"terraform_module,omitempty"`
+	Type            AppComponentType                   `json:"type,omitempty"`
+	UpdatedAt       string                             `json:"updated_at,omitempty"`
+	Version         int64                              `json:"version,omitempty"`
+}
+
+// AppComponentRelease is app.ComponentRelease.
+type AppComponentRelease struct {
+	BuildID           string                    `json:"build_id,omitempty"`
+	CreatedAt         string                    `json:"created_at,omitempty"`
+	CreatedByID       string                    `json:"created_by_id,omitempty"`
+	ID                string                    `json:"id,omitempty"`
+	ReleaseSteps      []AppComponentReleaseStep `json:"release_steps,omitempty"`
+	Status            string                    `json:"status,omitempty"`
+	StatusDescription string                    `json:"status_description,omitempty"`
+	TotalReleaseSteps int64                     `json:"total_release_steps,omitempty"`
+	UpdatedAt         string                    `json:"updated_at,omitempty"`
+}
+
+// AppComponentReleaseStep is app.ComponentReleaseStep.
+type AppComponentReleaseStep struct {
+	// parent release ID
+	ComponentReleaseID string `json:"component_release_id,omitempty"`
+	CreatedAt          string `json:"created_at,omitempty"`
+	CreatedByID        string `json:"created_by_id,omitempty"`
+	// fields to control the delay of the individual step, as this is set based on the parent strategy
+	Delay          string             `json:"delay,omitempty"`
+	ID             string             `json:"id,omitempty"`
+	InstallDeploys []AppInstallDeploy `json:"install_deploys,omitempty"`
+	// When a step is created, a set of installs are targeted. However, by the time the release step goes out, the
+	// install might have been setup in any order of ways.
+	RequestedInstallIDs []string `json:"requested_install_ids,omitempty"`
+	Status              string   `json:"status,omitempty"`
+	StatusDescription   string   `json:"status_description,omitempty"`
+	UpdatedAt           string   `json:"updated_at,omitempty"`
+}
+
+// AppComponentType is app.ComponentType.
+type AppComponentType string
+
+const (
+	AppComponentTypeTerraformModule    AppComponentType = "terraform_module"
+	AppComponentTypeHelmChart          AppComponentType = "helm_chart"
+	AppComponentTypeDockerBuild        AppComponentType = "docker_build"
+	AppComponentTypeExternalImage      AppComponentType = "external_image"
+	AppComponentTypeJob                AppComponentType = "job"
+	AppComponentTypeKubernetesManifest AppComponentType = "kubernetes_manifest"
+	AppComponentTypeUnknown            AppComponentType = "unknown"
+)
+
+// AppCompositeStatus is app.CompositeStatus.
+type AppCompositeStatus struct {
+	CreatedAtTs            int64                `json:"created_at_ts,omitempty"`
+	CreatedByID            string               `json:"created_by_id,omitempty"`
+	History                []AppCompositeStatus `json:"history,omitempty"`
+	Metadata               map[string]any       `json:"metadata,omitempty"`
+	Status                 AppStatus            `json:"status,omitempty"`
+	StatusHumanDescription string               `json:"status_human_description,omitempty"`
+}
+
+// AppConnectedGithubVCSConfig is app.ConnectedGithubVCSConfig.
+type AppConnectedGithubVCSConfig struct {
+	Branch string `json:"branch,omitempty"`
+	// parent component
+	ComponentConfigID   string            `json:"component_config_id,omitempty"`
+	ComponentConfigType string            `json:"component_config_type,omitempty"`
+	CreatedAt           string            `json:"created_at,omitempty"`
+	CreatedByID         string            `json:"created_by_id,omitempty"`
+	Directory           string            `json:"directory,omitempty"`
+	ID                  string            `json:"id,omitempty"`
+	Repo                string            `json:"repo,omitempty"`
+	RepoName            string            `json:"repo_name,omitempty"`
+	RepoOwner           string            `json:"repo_owner,omitempty"`
+	UpdatedAt           string            `json:"updated_at,omitempty"`
+	VCSConnection       *AppVCSConnection `json:"vcs_connection,omitempty"`
+	VCSConnectionID     string            `json:"vcs_connection_id,omitempty"`
+}
+
+// AppDockerBuildComponentConfig is app.DockerBuildComponentConfig.
+type AppDockerBuildComponentConfig struct {
+	BuildArgs []string `json:"build_args,omitempty"`
+	// value
+	ComponentConfigConnectionID string                       `json:"component_config_connection_id,omitempty"`
+	ConnectedGithubVCSConfig    *AppConnectedGithubVCSConfig `json:"connected_github_vcs_config,omitempty"`
+	CreatedAt                   string                       `json:"created_at,omitempty"`
+	CreatedByID                 string                       `json:"created_by_id,omitempty"`
+	Dockerfile                  string                       `json:"dockerfile,omitempty"`
+	EnvVars                     map[string]string            `json:"env_vars,omitempty"`
+	ID                          string                       `json:"id,omitempty"`
+	PublicGitVCSConfig          *AppPublicGitVCSConfig       `json:"public_git_vcs_config,omitempty"`
+	Target                      string                       `json:"target,omitempty"`
+	UpdatedAt                   string                       `json:"updated_at,omitempty"`
+}
+
+// AppDriftedObject is app.DriftedObject.
+type AppDriftedObject struct {
+	AppSandboxConfigID string `json:"app_sandbox_config_id,omitempty"`
+	ComponentBuildID   string `json:"component_build_id,omitempty"`
+	ComponentName      string `json:"component_name,omitempty"`
+	InstallComponentID string `json:"install_component_id,omitempty"`
+	InstallID          string `json:"install_id,omitempty"`
+	InstallSandboxID   string `json:"install_sandbox_id,omitempty"`
+	InstallWorkflowID  string `json:"install_workflow_id,omitempty"`
+	OrgID              string `json:"org_id,omitempty"`
+	TargetID           string `json:"target_id,omitempty"`
+	// These fields will be populated from the drifts_view
+	TargetType string `json:"target_type,omitempty"`
+}
+
+// AppExternalImageComponentConfig is app.ExternalImageComponentConfig.
+type AppExternalImageComponentConfig struct {
+	AWSEcrImageConfig *AppAWSECRImageConfig `json:"aws_ecr_image_config,omitempty"`
+	// value
+	ComponentConfigConnectionID string `json:"component_config_connection_id,omitempty"`
+	CreatedAt                   string `json:"created_at,omitempty"`
+	CreatedByID                 string `json:"created_by_id,omitempty"`
+	ID                          string `json:"id,omitempty"`
+	ImageURL                    string `json:"image_url,omitempty"`
+	Tag                         string `json:"tag,omitempty"`
+	UpdatedAt                   string `json:"updated_at,omitempty"`
+}
+
+// AppHelmChart is app.HelmChart.
+type AppHelmChart struct {
+	CreatedAt    string           `json:"created_at,omitempty"`
+	CreatedByID  string           `json:"created_by_id,omitempty"`
+	HelmReleases []AppHelmRelease `json:"helmReleases,omitempty"`
+	ID           string           `json:"id,omitempty"`
+	OrgID        string           `json:"org_id,omitempty"`
+	OwnerID      string           `json:"owner_id,omitempty"`
+	OwnerType    string           `json:"owner_type,omitempty"`
+	UpdatedAt    string           `json:"updated_at,omitempty"`
+}
+
+// AppHelmComponentConfig is app.HelmComponentConfig.
+type AppHelmComponentConfig struct {
+	// Helm specific configurations
+	ChartName string `json:"chart_name,omitempty"`
+	// parent reference
+	ComponentConfigConnectionID string                       `json:"component_config_connection_id,omitempty"`
+	ConnectedGithubVCSConfig    *AppConnectedGithubVCSConfig `json:"connected_github_vcs_config,omitempty"`
+	CreatedAt                   string                       `json:"created_at,omitempty"`
+	CreatedByID                 string                       `json:"created_by_id,omitempty"`
+	HelmConfigJSON              *AppHelmConfig               `json:"helm_config_json,omitempty"`
+	ID                          string                       `json:"id,omitempty"`
+	Namespace                   string                       `json:"namespace,omitempty"`
+	PublicGitVCSConfig          *AppPublicGitVCSConfig       `json:"public_git_vcs_config,omitempty"`
+	StorageDriver               string                       `json:"storage_driver,omitempty"`
+	// Newer config fields that we don't need a column for
+	TakeOwnership bool              `json:"take_ownership,omitempty"`
+	UpdatedAt     string            `json:"updated_at,omitempty"`
+	Values        map[string]string `json:"values,omitempty"`
+	ValuesFiles   []string          `json:"values_files,omitempty"`
+}
+
+// AppHelmConfig is app.HelmConfig.
+type AppHelmConfig struct {
+	ChartName      string             `json:"chart_name,omitempty"`
+	HelmRepoConfig *AppHelmRepoConfig `json:"helm_repo_config,omitempty"`
+	Namespace      string             `json:"namespace,omitempty"`
+	StorageDriver  string             `json:"storage_driver,omitempty"`
+	// Newer fields that we don't need to store as columns in the database
+	TakeOwnership bool              `json:"take_ownership,omitempty"`
+	Values        map[string]string `json:"values,omitempty"`
+	ValuesFiles   []string          `json:"values_files,omitempty"`
+}
+
+// AppHelmRelease is app.HelmRelease.
+type AppHelmRelease struct {
+	// The rspb.Release body, as a base64-encoded string
+	Body        string        `json:"body,omitempty"`
+	CreatedAt   string        `json:"created_at,omitempty"`
+	CreatedByID string        `json:"created_by_id,omitempty"`
+	HelmChart   *AppHelmChart `json:"helmChart,omitempty"`
+	HelmChartID string        `json:"helmChartID,omitempty"`
+	Key         string        `json:"key,omitempty"`
+	Labels      AppJSONMap    `json:"labels,omitempty"`
+	// Release "labels" that can be used as filters in the storage.Query(labels map[string]string)
+	// we implemented. Note that allowing Helm users to filter against new dimensions will require a
+	// new migration to be added, and the Create and/or update functions to be updated accordingly.
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	OrgID     string `json:"org_id,omitempty"`
+	Owner     string `json:"owner,omitempty"`
+	Status    string `json:"status,omitempty"`
+	// See https://github.com/helm/helm/blob/c9fe3d118caec699eb2565df9838673af379ce12/pkg/storage/driver/secrets.go#L231
+	Type      string `json:"type,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	Version   int64  `json:"version,omitempty"`
+}
+
+// AppHelmRepoConfig is app.HelmRepoConfig.
+type AppHelmRepoConfig struct {
+	Chart   string `json:"chart,omitempty"`
+	RepoURL string `json:"repo_url,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// AppInstall is app.Install.
+type AppInstall struct {
+	AppConfigID                         string                     `json:"app_config_id,omitempty"`
+	AppID                               string                     `json:"app_id,omitempty"`
+	AppRunnerConfig                     *AppAppRunnerConfig        `json:"app_runner_config,omitempty"`
+	AppSandboxConfig                    *AppAppSandboxConfig       `json:"app_sandbox_config,omitempty"`
+	AWSAccount                          *AppAWSAccount             `json:"aws_account,omitempty"`
+	AzureAccount                        *AppAzureAccount           `json:"azure_account,omitempty"`
+	CloudPlatform                       string                     `json:"cloud_platform,omitempty"`
+	ComponentStatuses                   map[string]string          `json:"component_statuses,omitempty"`
+	CompositeComponentStatus            string                     `json:"composite_component_status,omitempty"`
+	CompositeComponentStatusDescription string                     `json:"composite_component_status_description,omitempty"`
+	CreatedAt                           string                     `json:"created_at,omitempty"`
+	CreatedByID                         string                     `json:"created_by_id,omitempty"`
+	DriftedObjects                      []AppDriftedObject         `json:"drifted_objects,omitempty"`
+	ID                                  string                     `json:"id,omitempty"`
+	InstallActionWorkflows              []AppInstallActionWorkflow `json:"install_action_workflows,omitempty"`
+	InstallComponents                   []AppInstallComponent      `json:"install_components,omitempty"`
+	InstallConfig                       *AppInstallConfig          `json:"install_config,omitempty"`
+	InstallEvents                       []AppInstallEvent          `json:"install_events,omitempty"`
+	InstallInputs                       []AppInstallInputs         `json:"install_inputs,omitempty"`
+	InstallNumber                       int64                      `json:"install_number,omitempty"`
+	InstallSandboxRuns                  []AppInstallSandboxRun     `json:"install_sandbox_runs,omitempty"`
+	InstallStack                        *AppInstallStack           `json:"install_stack,omitempty"`
+	InstallStates                       []AppInstallState          `json:"install_states,omitempty"`
+	Links                               map[string]any             `json:"links,omitempty"`
+	Metadata                            map[string]string          `json:"metadata,omitempty"`
+	Name                                string                     `json:"name,omitempty"`
+	RunnerID                            string                     `json:"runner_id,omitempty"`
+	RunnerStatus                        string                     `json:"runner_status,omitempty"`
+	RunnerStatusDescription             string                     `json:"runner_status_description,omitempty"`
+	RunnerType                          string                     `json:"runner_type,omitempty"`
+	Sandbox                             *AppInstallSandbox         `json:"sandbox,omitempty"`
+	SandboxStatus                       string                     `json:"sandbox_status,omitempty"`
+	SandboxStatusDescription            string                     `json:"sandbox_status_description,omitempty"`
+	// TODO(jm): deprecate these fields once the terraform provider has been updated
+	Status            string        `json:"status,omitempty"`
+	StatusDescription string        `json:"status_description,omitempty"`
+	UpdatedAt         string        `json:"updated_at,omitempty"`
+	Workflows         []AppWorkflow `json:"workflows,omitempty"`
+}
+
+// AppInstallActionWorkflow is app.InstallActionWorkflow.
+type AppInstallActionWorkflow struct {
+	ActionWorkflow   *AppActionWorkflow            `json:"action_workflow,omitempty"`
+	ActionWorkflowID string                        `json:"action_workflow_id,omitempty"`
+	CreatedAt        string                        `json:"created_at,omitempty"`
+	CreatedByID      string                        `json:"created_by_id,omitempty"`
+	ID               string                        `json:"id,omitempty"`
+	InstallID        string                        `json:"install_id,omitempty"`
+	Runs             []AppInstallActionWorkflowRun `json:"runs,omitempty"`
+	// after query fields filled in after querying
+	Status    string `json:"status,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// AppInstallActionWorkflowRun is app.InstallActionWorkflowRun.
+type AppInstallActionWorkflowRun struct {
+	ActionWorkflowConfigID string                   `json:"action_workflow_config_id,omitempty"`
+	Config                 *AppActionWorkflowConfig `json:"config,omitempty"`
+	CreatedAt              string                   `json:"created_at,omitempty"`
+	CreatedBy              *AppAccount              `json:"created_by,omitempty"`
+	CreatedByID            string                   `json:"created_by_id,omitempty"`
+	// after query
+	ExecutionTime           int64                     `json:"execution_time,omitempty"`
+	ID                      string                    `json:"id,omitempty"`
+	InstallActionWorkflow   *AppInstallActionWorkflow `json:"install_action_workflow,omitempty"`
+	InstallActionWorkflowID string                    `json:"install_action_workflow_id,omitempty"`
+	InstallID               string                    `json:"install_id,omitempty"`
+	InstallWorkflowID       string                    `json:"install_workflow_id,omitempty"`
+	LogStream               *AppLogStream             `json:"log_stream,omitempty"`
+	Outputs                 map[string]any            `json:"outputs,omitempty"`
+	// Role to be used when running this action
+	Role              string                            `json:"role,omitempty"`
+	RunEnvVars        map[string]string                 `json:"run_env_vars,omitempty"`
+	RunnerJob         *AppRunnerJob                     `json:"runner_job,omitempty"`
+	Status            string                            `json:"status,omitempty"`
+	StatusDescription string                            `json:"status_description,omitempty"`
+	StatusV2          *AppCompositeStatus               `json:"status_v2,omitempty"`
+	Steps             []AppInstallActionWorkflowRunStep `json:"steps,omitempty"`
+	TriggerType       AppActionWorkflowTriggerType      `json:"trigger_type,omitempty"`
+	TriggeredByID     string                            `json:"triggered_by_id,omitempty"`
+	TriggeredByType   string                            `json:"triggered_by_type,omitempty"`
+	UpdatedAt         string                            `json:"updated_at,omitempty"`
+	Workflow          *AppWorkflow                      `json:"workflow,omitempty"`
+	WorkflowID        string                            `json:"workflow_id,omitempty"`
+}
+
+// AppInstallActionWorkflowRunStatus is app.InstallActionWorkflowRunStatus.
+type AppInstallActionWorkflowRunStatus string
+
+const (
+	AppInstallActionWorkflowRunStatusFinished   AppInstallActionWorkflowRunStatus = "finished"
+	AppInstallActionWorkflowRunStatusQueued     AppInstallActionWorkflowRunStatus = "queued"
+	AppInstallActionWorkflowRunStatusInProgress AppInstallActionWorkflowRunStatus = "in-progress"
+	AppInstallActionWorkflowRunStatusError      AppInstallActionWorkflowRunStatus = "error"
+	AppInstallActionWorkflowRunStatusTimedOut   AppInstallActionWorkflowRunStatus = "timed-out"
+	AppInstallActionWorkflowRunStatusCancelled  AppInstallActionWorkflowRunStatus = "cancelled"
+	AppInstallActionWorkflowRunStatusUnknown    AppInstallActionWorkflowRunStatus = "unknown"
+)
+
+// AppInstallActionWorkflowRunStep is app.InstallActionWorkflowRunStep.
+type AppInstallActionWorkflowRunStep struct {
+	AdhocConfig                *AppAdHocStepConfig                   `json:"adhoc_config,omitempty"`
+	CreatedAt                  string                                `json:"created_at,omitempty"`
+	CreatedByID                string                                `json:"created_by_id,omitempty"`
+	ExecutionDuration          int64                                 `json:"execution_duration,omitempty"`
+	ID                         string                                `json:"id,omitempty"`
+	InstallActionWorkflowRunID string                                `json:"install_action_workflow_run_id,omitempty"`
+	Status                     AppInstallActionWorkflowRunStepStatus `json:"status,omitempty"`
+	StepID                     string                                `json:"step_id,omitempty"`
+	UpdatedAt                  string                                `json:"updated_at,omitempty"`
+}
+
+// AppInstallActionWorkflowRunStepStatus is app.InstallActionWorkflowRunStepStatus.
+type AppInstallActionWorkflowRunStepStatus string
+
+const (
+	AppInstallActionWorkflowRunStepStatusFinished   AppInstallActionWorkflowRunStepStatus = "finished"
+	AppInstallActionWorkflowRunStepStatusPending    AppInstallActionWorkflowRunStepStatus = "pending"
+	AppInstallActionWorkflowRunStepStatusInProgress AppInstallActionWorkflowRunStepStatus = "in-progress"
+	AppInstallActionWorkflowRunStepStatusTimedOut   AppInstallActionWorkflowRunStepStatus = "timed-out"
+	AppInstallActionWorkflowRunStepStatusError      AppInstallActionWorkflowRunStepStatus = "error"
+)
+
+// AppInstallApprovalOption is app.InstallApprovalOption.
+type AppInstallApprovalOption string
+
+const (
+	AppInstallApprovalOptionApproveAll AppInstallApprovalOption = "approve-all"
+	AppInstallApprovalOptionPrompt     AppInstallApprovalOption = "prompt"
+)
+
+// AppInstallAuditLog is app.InstallAuditLog.
+type AppInstallAuditLog struct {
+	InstallID string `json:"install_id,omitempty"`
+	LogLine   string `json:"log_line,omitempty"`
+	TimeStamp string `json:"time_stamp,omitempty"`
+	Type      string `json:"type,omitempty"`
+}
+
+// AppInstallComponent is app.InstallComponent.
+type AppInstallComponent struct {
+	Component          *AppComponent          `json:"component,omitempty"`
+	ComponentID        string                 `json:"component_id,omitempty"`
+	CreatedAt          string                 `json:"created_at,omitempty"`
+	CreatedByID        string                 `json:"created_by_id,omitempty"`
+	DriftedObject      *AppDriftedObject      `json:"drifted_object,omitempty"`
+	HelmChart          *AppHelmChart          `json:"helm_chart,omitempty"`
+	ID                 string                 `json:"id,omitempty"`
+	InstallDeploys     []AppInstallDeploy     `json:"install_deploys,omitempty"`
+	InstallID          string                 `json:"install_id,omitempty"`
+	Links              map[string]any         `json:"links,omitempty"`
+	Status             string                 `json:"status,omitempty"`
+	StatusDescription  string                 `json:"status_description,omitempty"`
+	StatusV2           *AppCompositeStatus    `json:"status_v2,omitempty"`
+	TerraformWorkspace *AppTerraformWorkspace `json:"terraform_workspace,omitempty"`
+	UpdatedAt          string                 `json:"updated_at,omitempty"`
+}
+
+// AppInstallConfig is app.InstallConfig.
+type AppInstallConfig struct {
+	ApprovalOption AppInstallApprovalOption `json:"approval_option,omitempty"`
+	CreatedAt      string                   `json:"created_at,omitempty"`
+	CreatedByID    string                   `json:"created_by_id,omitempty"`
+	ID             string                   `json:"id,omitempty"`
+	InstallID      string                   `json:"install_id,omitempty"`
+	OrgID          string                   `json:"org_id,omitempty"`
+	UpdatedAt      string                   `json:"updated_at,omitempty"`
+}
+
+// AppInstallDeploy is app.InstallDeploy.
+type AppInstallDeploy struct {
+	ActionWorkflowRuns     []AppInstallActionWorkflowRun `json:"action_workflow_runs,omitempty"`
+	BuildID                string                        `json:"build_id,omitempty"`
+	ComponentBuild         *AppComponentBuild            `json:"component_build,omitempty"`
+	ComponentConfigVersion int64                         `json:"component_config_version,omitempty"`
+	ComponentID            string                        `json:"component_id,omitempty"`
+	ComponentName          string                        `json:"component_name,omitempty"`
+	CreatedAt              string                        `json:"created_at,omitempty"`
+	CreatedBy              *AppAccount                   `json:"created_by,omitempty"`
+	CreatedByID            string                        `json:"created_by_id,omitempty"`
+	ID                     string                        `json:"id,omitempty"`
+	InstallComponentID     string                        `json:"install_component_id,omitempty"`
+	InstallDeployType      AppInstallDeployType          `json:"install_deploy_type,omitempty"`
+	// Fields that are de-nested at read time using AfterQuery
+	InstallID string `json:"install_id,omitempty"`
+	// DEPRECATED: use WorkflowID
+	InstallWorkflowID string            `json:"install_workflow_id,omitempty"`
+	LogStream         *AppLogStream     `json:"log_stream,omitempty"`
+	OCIArtifact       *AppOCIArtifact   `json:"oci_artifact,omitempty"`
+	Outputs           map[string]any    `json:"outputs,omitempty"`
+	PlanOnly          bool              `json:"plan_only,omitempty"`
+	PolicyReports     []AppPolicyReport `json:"policy_reports,omitempty"`
+	ReleaseID         string            `json:"release_id,omitempty"`
+	// Role to be used when running this component
+	Role string `json:"role,omitempty"`
+	// runner details
+	RunnerJobs        []AppRunnerJob      `json:"runner_jobs,omitempty"`
+	Status            string              `json:"status,omitempty"`
+	StatusDescription string              `json:"status_description,omitempty"`
+	StatusV2          *AppCompositeStatus `json:"status_v2,omitempty"`
+	UpdatedAt         string              `json:"updated_at,omitempty"`
+	Workflow          *AppWorkflow        `json:"workflow,omitempty"`
+	WorkflowID        string              `json:"workflow_id,omitempty"`
+}
+
+// AppInstallDeployType is app.InstallDeployType.
+type AppInstallDeployType string
+
+const (
+	AppInstallDeployTypeSyncImage AppInstallDeployType = "sync-image"
+	AppInstallDeployTypeApply     AppInstallDeployType = "apply"
+	AppInstallDeployTypeTeardown  AppInstallDeployType = "teardown"
+)
+
+// AppInstallEvent is app.InstallEvent.
+type AppInstallEvent struct {
+	CreatedAt       string             `json:"created_at,omitempty"`
+	CreatedByID     string             `json:"created_by_id,omitempty"`
+	ID              string             `json:"id,omitempty"`
+	InstallID       string             `json:"install_id,omitempty"`
+	Operation       string             `json:"operation,omitempty"`
+	OperationName   string             `json:"operation_name,omitempty"`
+	OperationStatus AppOperationStatus `json:"operation_status,omitempty"`
+	OrgID           string             `json:"org_id,omitempty"`
+	Payload         map[string]string  `json:"payload,omitempty"`
+	UpdatedAt       string             `json:"updated_at,omitempty"`
+}
+
+// AppInstallInputs is app.InstallInputs.
+type AppInstallInputs struct {
+	AppInputConfigID string            `json:"app_input_config_id,omitempty"`
+	CreatedAt        string            `json:"created_at,omitempty"`
+	CreatedByID      string            `json:"created_by_id,omitempty"`
+	ID               string            `json:"id,omitempty"`
+	InstallID        string            `json:"install_id,omitempty"`
+	OrgID            string            `json:"org_id,omitempty"`
+	RedactedValues   map[string]string `json:"redacted_values,omitempty"`
+	UpdatedAt        string            `json:"updated_at,omitempty"`
+	Values           map[string]string `json:"values,omitempty"`
+}
+
+// AppInstallSandbox is app.InstallSandbox.
+type AppInstallSandbox struct {
+	CreatedAt          string                 `json:"created_at,omitempty"`
+	CreatedByID        string                 `json:"created_by_id,omitempty"`
+	ID                 string                 `json:"id,omitempty"`
+	InstallID          string                 `json:"install_id,omitempty"`
+	InstallSandboxRuns []AppInstallSandboxRun `json:"install_sandbox_runs,omitempty"`
+	Status             string                 `json:"status,omitempty"`
+	StatusDescription  string                 `json:"status_description,omitempty"`
+	StatusV2           *AppCompositeStatus    `json:"status_v2,omitempty"`
+	TerraformWorkspace *AppTerraformWorkspace `json:"terraform_workspace,omitempty"`
+	UpdatedAt          string                 `json:"updated_at,omitempty"`
+}
+
+// AppInstallSandboxRun is app.InstallSandboxRun.
+type AppInstallSandboxRun struct {
+	ActionWorkflowRuns []AppInstallActionWorkflowRun `json:"action_workflow_runs,omitempty"`
+	AppSandboxConfig   *AppAppSandboxConfig          `json:"app_sandbox_config,omitempty"`
+	CreatedAt          string                        `json:"created_at,omitempty"`
+	CreatedBy          *AppAccount                   `json:"created_by,omitempty"`
+	CreatedByID        string                        `json:"created_by_id,omitempty"`
+	ID                 string                        `json:"id,omitempty"`
+	InstallID          string                        `json:"install_id,omitempty"`
+	// TODO: once we run a backfill we can make this non pointer
+	InstallSandboxID  string            `json:"install_sandbox_id,omitempty"`
+	InstallWorkflowID string            `json:"install_workflow_id,omitempty"`
+	LogStream         *AppLogStream     `json:"log_stream,omitempty"`
+	Outputs           map[string]any    `json:"outputs,omitempty"`
+	PolicyReports     []AppPolicyReport `json:"policy_reports,omitempty"`
+	// Role to be used when planning and applying sandbox runs
+	Role    string            `json:"role,omitempty"`
+	RunType AppSandboxRunType `json:"run_type,omitempty"`
+	// runner details
+	RunnerJobs        []AppRunnerJob      `json:"runner_jobs,omitempty"`
+	Status            string              `json:"status,omitempty"`
+	StatusDescription string              `json:"status_description,omitempty"`
+	StatusV2          *AppCompositeStatus `json:"status_v2,omitempty"`
+	UpdatedAt         string              `json:"updated_at,omitempty"`
+	Workflow          *AppWorkflow        `json:"workflow,omitempty"`
+	// Fields that are de-nested at read time using AfterQuery
+	WorkflowID string `json:"workflow_id,omitempty"`
+}
+
+// AppInstallStack is app.InstallStack.
+type AppInstallStack struct {
+	CreatedAt           string                   `json:"created_at,omitempty"`
+	CreatedByID         string                   `json:"created_by_id,omitempty"`
+	ID                  string                   `json:"id,omitempty"`
+	InstallID           string                   `json:"install_id,omitempty"`
+	InstallStackOutputs *AppInstallStackOutputs  `json:"install_stack_outputs,omitempty"`
+	OrgID               string                   `json:"org_id,omitempty"`
+	UpdatedAt           string                   `json:"updated_at,omitempty"`
+	Versions            []AppInstallStackVersion `json:"versions,omitempty"`
+}
+
+// AppInstallStackOutputs is app.InstallStackOutputs.
+type AppInstallStackOutputs struct {
+	AWS                 *AppAWSStackOutputs   `json:"aws,omitempty"`
+	Azure               *AppAzureStackOutputs `json:"azure,omitempty"`
+	CreatedAt           string                `json:"created_at,omitempty"`
+	CreatedByID         string                `json:"created_by_id,omitempty"`
+	Data                map[string]string     `json:"data,omitempty"`
+	DataContents        map[string]any        `json:"data_contents,omitempty"`
+	ID                  string                `json:"id,omitempty"`
+	InstallStackID      string                `json:"install_stack_id,omitempty"`
+	InstallVersionRunID string                `json:"install_version_run_id,omitempty"`
+	OrgID               string                `json:"org_id,omitempty"`
+	UpdatedAt           string                `json:"updated_at,omitempty"`
+}
+
+// AppInstallStackVersion is app.InstallStackVersion.
+type AppInstallStackVersion struct {
+	AppConfigID  string `json:"app_config_id,omitempty"`
+	AWSBucketKey string `json:"aws_bucket_key,omitempty"`
+	// aws configuration parameters
+	AWSBucketName   string                      `json:"aws_bucket_name,omitempty"`
+	Checksum        string                      `json:"checksum,omitempty"`
+	CompositeStatus *AppCompositeStatus         `json:"composite_status,omitempty"`
+	Contents        string                      `json:"contents,omitempty"`
+	CreatedAt       string                      `json:"created_at,omitempty"`
+	CreatedByID     string                      `json:"created_by_id,omitempty"`
+	ID              string                      `json:"id,omitempty"`
+	InstallID       string                      `json:"install_id,omitempty"`
+	InstallStackID  string                      `json:"install_stack_id,omitempty"`
+	OrgID           string                      `json:"org_id,omitempty"`
+	PhoneHomeID     string                      `json:"phone_home_id,omitempty"`
+	PhoneHomeURL    string                      `json:"phone_home_url,omitempty"`
+	QuickLinkURL    string                      `json:"quick_link_url,omitempty"`
+	Runs            []AppInstallStackVersionRun `json:"runs,omitempty"`
+	TemplateURL     string                      `json:"template_url,omitempty"`
+	UpdatedAt       string                      `json:"updated_at,omitempty"`
+}
+
+// AppInstallStackVersionRun is app.InstallStackVersionRun.
+type AppInstallStackVersionRun struct {
+	CreatedAt    string            `json:"created_at,omitempty"`
+	CreatedByID  string            `json:"created_by_id,omitempty"`
+	Data         map[string]string `json:"data,omitempty"`
+	DataContents map[string]any    `json:"data_contents,omitempty"`
+	ID           string            `json:"id,omitempty"`
+	UpdatedAt    string            `json:"updated_at,omitempty"`
+}
+
+// AppInstallState is app.InstallState.
+type AppInstallState struct {
+	Archived        bool              `json:"archived,omitempty"`
+	Contents        string            `json:"contents,omitempty"`
+	CreatedAt       string            `json:"created_at,omitempty"`
+	CreatedByID     string            `json:"created_by_id,omitempty"`
+	ID              string            `json:"id,omitempty"`
+	InstallID       string            `json:"install_id,omitempty"`
+	StaleAt         *GenericsNullTime `json:"stale_at,omitempty"`
+	TriggeredByID   string            `json:"triggered_by_id,omitempty"`
+	TriggeredByType string            `json:"triggered_by_type,omitempty"`
+	UpdatedAt       string            `json:"updated_at,omitempty"`
+	Version         int64             `json:"version,omitempty"`
+}
+
+// AppJSONMap is app.JSONMap.
+type AppJSONMap map[string]string
+
+// AppJobComponentConfig is app.JobComponentConfig.
+type AppJobComponentConfig struct {
+	Args []string `json:"args,omitempty"`
+	Cmd  []string `json:"cmd,omitempty"`
+	// value
+	ComponentConfigConnectionID string            `json:"component_config_connection_id,omitempty"`
+	CreatedAt                   string            `json:"created_at,omitempty"`
+	CreatedByID                 string            `json:"created_by_id,omitempty"`
+	EnvVars                     map[string]string `json:"env_vars,omitempty"`
+	ID                          string            `json:"id,omitempty"`
+	// Image attributes, copied from a docker_buid or external_image component.
+	ImageURL  string `json:"image_url,omitempty"`
+	Tag       string `json:"tag,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// AppKubernetesManifestComponentConfig is app.KubernetesManifestComponentConfig.
+type AppKubernetesManifestComponentConfig struct {
+	// value
+	ComponentConfigConnectionID string                       `json:"component_config_connection_id,omitempty"`
+	ConnectedGithubVCSConfig    *AppConnectedGithubVCSConfig `json:"connected_github_vcs_config,omitempty"`
+	CreatedAt                   string                       `json:"created_at,omitempty"`
+	CreatedByID                 string                       `json:"created_by_id,omitempty"`
+	ID                          string                       `json:"id,omitempty"`
+	// Kustomize configuration (mutually exclusive with Manifest)
+	Kustomize *AppKustomizeConfig `json:"kustomize,omitempty"`
+	// Primary fields - used for inline manifests (fully supported)
+	Manifest  string `json:"manifest,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	// VCS configuration for kustomize sources (similar to HelmComponentConfig)
+	PublicGitVCSConfig *AppPublicGitVCSConfig `json:"public_git_vcs_config,omitempty"`
+	UpdatedAt          string                 `json:"updated_at,omitempty"`
+}
+
+// AppKustomizeConfig is app.KustomizeConfig.
+type AppKustomizeConfig struct {
+	// Enable Helm chart inflation during kustomize build
+	EnableHelm bool `json:"enable_helm,omitempty"`
+	// Load restrictor: "none" or "rootOnly" (default: "rootOnly")
+	LoadRestrictor string `json:"load_restrictor,omitempty"`
+	// Additional patch files to apply after kustomize build
+	Patches []string `json:"patches,omitempty"`
+	// Path to kustomization directory (relative to source root)
+	Path string `json:"path,omitempty"`
+}
+
+// AppLatestRunnerHeartBeat is app.LatestRunnerHeartBeat.
+type AppLatestRunnerHeartBeat struct {
+	AliveTime int64  `json:"alive_time,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	Process   string `json:"process,omitempty"`
+	RunnerID  string `json:"runner_id,omitempty"`
+	StartedAt string `json:"started_at,omitempty"`
+	Version   string `json:"version,omitempty"`
+}
+
+// AppLogStream is app.LogStream.
+type AppLogStream struct {
+	Attrs        map[string]string `json:"attrs,omitempty"`
+	CreatedAt    string            `json:"created_at,omitempty"`
+	CreatedByID  string            `json:"created_by_id,omitempty"`
+	ID           string            `json:"id,omitempty"`
+	Open         bool              `json:"open,omitempty"`
+	OrgID        string            `json:"org_id,omitempty"`
+	OwnerID      string            `json:"owner_id,omitempty"`
+	OwnerType    string            `json:"owner_type,omitempty"`
+	RunnerAPIURL string            `json:"runner_api_url,omitempty"`
+	UpdatedAt    string            `json:"updated_at,omitempty"`
+	WriteToken   string            `json:"write_token,omitempty"`
+}
+
+// AppNotificationsConfig is app.NotificationsConfig.
+type AppNotificationsConfig struct {
+	CreatedAt       string `json:"created_at,omitempty"`
+	CreatedByID     string `json:"created_by_id,omitempty"`
+	ID              string `json:"id,omitempty"`
+	OrgID           string `json:"org_id,omitempty"`
+	OwnerID         string `json:"owner_id,omitempty"`
+	OwnerType       string `json:"owner_type,omitempty"`
+	SlackWebhookURL string `json:"slack_webhook_url,omitempty"`
+	UpdatedAt       string `json:"updated_at,omitempty"`
+}
+
+// AppOCIArtifact is app.OCIArtifact.
+type AppOCIArtifact struct {
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	Architecture string            `json:"architecture,omitempty"`
+	ArtifactType string            `json:"artifact_type,omitempty"`
+	CreatedAt    string            `json:"created_at,omitempty"`
+	CreatedByID  string            `json:"created_by_id,omitempty"`
+	Digest       string            `json:"digest,omitempty"`
+	ID           string            `json:"id,omitempty"`
+	MediaType    string            `json:"media_type,omitempty"`
+	OrgID        string            `json:"org_id,omitempty"`
+	// Platform fields
+	OS         string   `json:"os,omitempty"`
+	OSFeatures []string `json:"os_features,omitempty"`
+	OSVersion  string   `json:"os_version,omitempty"`
+	OwnerID    string   `json:"owner_id,omitempty"`
+	OwnerType  string   `json:"owner_type,omitempty"`
+	Repository string   `json:"repository,omitempty"`
+	Size       int64    `json:"size,omitempty"`
+	Tag        string   `json:"tag,omitempty"`
+	UpdatedAt  string   `json:"updated_at,omitempty"`
+	Urls       []string `json:"urls,omitempty"`
+	Variant    string   `json:"variant,omitempty"`
+}
+
+// AppOperationStatus is app.OperationStatus.
+type AppOperationStatus string
+
+const (
+	AppOperationStatusStarted  AppOperationStatus = "started"
+	AppOperationStatusFinished AppOperationStatus = "finished"
+	AppOperationStatusNoop     AppOperationStatus = "noop"
+	AppOperationStatusFailed   AppOperationStatus = "failed"
+)
+
+// AppOperationType is app.OperationType.
+type AppOperationType string
+
+const (
+	AppOperationTypeProvision   AppOperationType = "provision"
+	AppOperationTypeDeprovision AppOperationType = "deprovision"
+	AppOperationTypeDeploy      AppOperationType = "deploy"
+	AppOperationTypeTeardown    AppOperationType = "teardown"
+	AppOperationTypeReprovision AppOperationType = "reprovision"
+	AppOperationTypeTrigger     AppOperationType = "trigger"
+)
+
+// AppOrg is app.Org.
+type AppOrg struct {
+	// Transient fields for counts (not persisted to database)
+	AppCount            int64                   `json:"app_count,omitempty"`
+	CreatedAt           string                  `json:"created_at,omitempty"`
+	CreatedByID         string                  `json:"created_by_id,omitempty"`
+	Features            TypesStringBoolMap      `json:"features,omitempty"`
+	ID                  string                  `json:"id,omitempty"`
+	InstallCount        int64                   `json:"install_count,omitempty"`
+	Links               map[string]any          `json:"links,omitempty"`
+	LogoURL             string                  `json:"logo_url,omitempty"`
+	Name                string                  `json:"name,omitempty"`
+	NotificationsConfig *AppNotificationsConfig `json:"notifications_config,omitempty"`
+	RunnerGroup         *AppRunnerGroup         `json:"runner_group,omitempty"`
+	SandboxMode         bool                    `json:"sandbox_mode,omitempty"`
+	Status              string                  `json:"status,omitempty"`
+	StatusDescription   string                  `json:"status_description,omitempty"`
+	Tags                []string                `json:"tags,omitempty"`
+	UpdatedAt           string                  `json:"updated_at,omitempty"`
+	VCSConnections      []AppVCSConnection      `json:"vcs_connections,omitempty"`
+}
+
+// AppOrgFeatureInfo is app.OrgFeatureInfo.
+type AppOrgFeatureInfo struct {
+	Description string `json:"description,omitempty"`
+	Name        string `json:"name,omitempty"`
+}
+
+// AppOrgInvite is app.OrgInvite.
+type AppOrgInvite struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	CreatedByID string `json:"created_by_id,omitempty"`
+	Email       string `json:"email,omitempty"`
+	ID          string `json:"id,omitempty"`
+	// parent relationship
+	OrgID     string             `json:"org_id,omitempty"`
+	RoleType  AppRoleType        `json:"role_type,omitempty"`
+	Status    AppOrgInviteStatus `json:"status,omitempty"`
+	UpdatedAt string             `json:"updated_at,omitempty"`
+}
+
+// AppOrgInviteStatus is app.OrgInviteStatus.
+type AppOrgInviteStatus string
+
+const (
+	AppOrgInviteStatusPending  AppOrgInviteStatus = "pending"
+	AppOrgInviteStatusAccepted AppOrgInviteStatus = "accepted"
+)
+
+// AppOtelLogRecord is app.OtelLogRecord.
+type AppOtelLogRecord struct {
+	Body          string            `json:"body,omitempty"`
+	CreatedAt     string            `json:"created_at,omitempty"`
+	CreatedByID   string            `json:"created_by_id,omitempty"`
+	ID            string            `json:"id,omitempty"`
+	LogAttributes map[string]string `json:"log_attributes,omitempty"`
+	LogStreamID   string            `json:"log_stream_id,omitempty"`
+	// internal attributes
+	OrgID                  string            `json:"org_id,omitempty"`
+	ResourceAttributes     map[string]string `json:"resource_attributes,omitempty"`
+	ResourceSchemaURL      string            `json:"resource_schema_url,omitempty"`
+	RunnerGroupID          string            `json:"runner_group_id,omitempty"`
+	RunnerID               string            `json:"runner_id,omitempty"`
+	RunnerJobExecutionID   string            `json:"runner_job_execution_id,omitempty"`
+	RunnerJobExecutionStep string            `json:"runner_job_execution_step,omitempty"`
+	RunnerJobID            string            `json:"runner_job_id,omitempty"`
+	ScopeAttributes        map[string]string `json:"scope_attributes,omitempty"`
+	ScopeName              string            `json:"scope_name,omitempty"`
+	ScopeSchemaURL         string            `json:"scope_schema_url,omitempty"`
+	ScopeVersion           string            `json:"scope_version,omitempty"`
+	ServiceName            string            `json:"service_name,omitempty"`
+	SeverityNumber         int64             `json:"severity_number,omitempty"`
+	SeverityText           string            `json:"severity_text,omitempty"`
+	SpanID                 string            `json:"span_id,omitempty"`
+	// OTEL log message attributes
+	Timestamp     string `json:"timestamp,omitempty"`
+	TimestampDate string `json:"timestamp_date,omitempty"`
+	TimestampTime string `json:"timestamp_time,omitempty"`
+	TraceFlags    int64  `json:"trace_flags,omitempty"`
+	TraceID       string `json:"trace_id,omitempty"`
+	UpdatedAt     string `json:"updated_at,omitempty"`
+}
+
+// AppPolicy is app.Policy.
+type AppPolicy struct {
+	CreatedAt   string        `json:"created_at,omitempty"`
+	CreatedByID string        `json:"created_by_id,omitempty"`
+	ID          string        `json:"id,omitempty"`
+	Name        AppPolicyName `json:"name,omitempty"`
+	// Permissions are used to track granular permissions for each domain
+	Permissions map[string]string `json:"permissions,omitempty"`
+	RoleID      string            `json:"role_id,omitempty"`
+	UpdatedAt   string            `json:"updated_at,omitempty"`
+}
+
+// AppPolicyInputRef is app.PolicyInputRef.
+type AppPolicyInputRef struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// AppPolicyName is app.PolicyName.
+type AppPolicyName string
+
+const (
+	AppPolicyNameOrgAdmin        AppPolicyName = "org_admin"
+	AppPolicyNameInstaller       AppPolicyName = "installer"
+	AppPolicyNameRunner          AppPolicyName = "runner"
+	AppPolicyNameHostedInstaller AppPolicyName = "hosted_installer"
+)
+
+// AppPolicyReport is app.PolicyReport.
+type AppPolicyReport struct {
+	// Denormalized context for filtering
+	AppID         string `json:"app_id,omitempty"`
+	AppName       string `json:"app_name,omitempty"`
+	ComponentID   string `json:"component_id,omitempty"`
+	ComponentName string `json:"component_name,omitempty"`
+	CreatedAt     string `json:"created_at,omitempty"`
+	CreatedByID   string `json:"created_by_id,omitempty"`
+	// Summary counts for list views
+	DenyCount int64 `json:"deny_count,omitempty"`
+	// Canonical policy evaluation data
+	EvaluatedAt string              `json:"evaluated_at,omitempty"`
+	ID          string              `json:"id,omitempty"`
+	Inputs      []AppPolicyInputRef `json:"inputs,omitempty"`
+	InstallID   string              `json:"install_id,omitempty"`
+	InstallName string              `json:"install_name,omitempty"`
+	// Denormalized display names for human-readable reports
+	OrgName string `json:"org_name,omitempty"`
+	// Polymorphic relationship to the impacted Nuon resource (indexes defined in Indexes())
+	OwnerID     string                   `json:"owner_id,omitempty"`
+	OwnerType   AppPolicyReportOwnerType `json:"owner_type,omitempty"`
+	PassCount   int64                    `json:"pass_count,omitempty"`
+	Policies    []AppPolicyResult        `json:"policies,omitempty"`
+	PolicyIDs   []string                 `json:"policy_ids,omitempty"`
+	RunnerJobID string                   `json:"runner_job_id,omitempty"`
+	Status      *AppCompositeStatus      `json:"status,omitempty"`
+	UpdatedAt   string                   `json:"updated_at,omitempty"`
+	Violations  []AppPolicyViolation     `json:"violations,omitempty"`
+	WarnCount   int64                    `json:"warn_count,omitempty"`
+	// Optional context references (indexes defined in Indexes())
+	WorkflowStepPolicyValidationID string `json:"workflow_step_policy_validation_id,omitempty"`
+}
+
+// AppPolicyReportOwnerType is app.PolicyReportOwnerType.
+type AppPolicyReportOwnerType string
+
+const (
+	AppPolicyReportOwnerTypeInstallDeploys     AppPolicyReportOwnerType = "install_deploys"
+	AppPolicyReportOwnerTypeInstallSandboxRuns AppPolicyReportOwnerType = "install_sandbox_runs"
+	AppPolicyReportOwnerTypeComponentBuilds    AppPolicyReportOwnerType = "component_builds"
+)
+
+// AppPolicyResult is app.PolicyResult.
+type AppPolicyResult struct {
+	DenyCount  int64  `json:"deny_count,omitempty"`
+	InputCount int64  `json:"input_count,omitempty"`
+	PassCount  int64  `json:"pass_count,omitempty"`
+	PolicyID   string `json:"policy_id,omitempty"`
+	PolicyName string `json:"policy_name,omitempty"`
+	// "deny", "warn", or "pass"
+	Status    string `json:"status,omitempty"`
+	WarnCount int64  `json:"warn_count,omitempty"`
+}
+
+// AppPolicyViolation is app.PolicyViolation.
+type AppPolicyViolation struct {
+	// Human-readable input reference (e.g., "Deployment/default/nginx")
+	InputIdentity string `json:"input_identity,omitempty"`
+	InputIndex    int64  `json:"input_index,omitempty"`
+	Message       string `json:"message,omitempty"`
+	PolicyID      string `json:"policy_id,omitempty"`
+	PolicyName    string `json:"policy_name,omitempty"`
+	// "deny" or "warn"
+	Severity string `json:"severity,omitempty"`
+}
+
+// AppProviderType is app.ProviderType.
+type AppProviderType string
+
+const (
+	AppProviderTypeOidc   AppProviderType = "oidc"
+	AppProviderTypeGoogle AppProviderType = "google"
+	AppProviderTypeGithub AppProviderType = "github"
+)
+
+// AppPublicGitVCSConfig is app.PublicGitVCSConfig.
+type AppPublicGitVCSConfig struct {
+	Branch              string `json:"branch,omitempty"`
+	ComponentConfigID   string `json:"component_config_id,omitempty"`
+	ComponentConfigType string `json:"component_config_type,omitempty"`
+	CreatedAt           string `json:"created_at,omitempty"`
+	CreatedByID         string `json:"created_by_id,omitempty"`
+	Directory           string `json:"directory,omitempty"`
+	ID                  string `json:"id,omitempty"`
+	// actual configuration
+	Repo      string `json:"repo,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// AppRole is app.Role.
+type AppRole struct {
+	CreatedBy   *AppAccount `json:"createdBy,omitempty"`
+	CreatedAt   string      `json:"created_at,omitempty"`
+	CreatedByID string      `json:"created_by_id,omitempty"`
+	ID          string      `json:"id,omitempty"`
+	Policies    []AppPolicy `json:"policies,omitempty"`
+	RoleType    AppRoleType `json:"role_type,omitempty"`
+	UpdatedAt   string      `json:"updated_at,omitempty"`
+}
+
+// AppRoleType is app.RoleType.
+type AppRoleType string
+
+const (
+	AppRoleTypeOrgAdmin        AppRoleType = "org_admin"
+	AppRoleTypeInstaller       AppRoleType = "installer"
+	AppRoleTypeRunner          AppRoleType = "runner"
+	AppRoleTypeHostedInstaller AppRoleType = "hosted-installer"
+)
+
+// AppRunner is app.Runner.
+type AppRunner struct {
+	CreatedAt         string               `json:"created_at,omitempty"`
+	CreatedByID       string               `json:"created_by_id,omitempty"`
+	DisplayName       string               `json:"display_name,omitempty"`
+	ID                string               `json:"id,omitempty"`
+	Jobs              []AppRunnerJob       `json:"jobs,omitempty"`
+	Name              string               `json:"name,omitempty"`
+	Operations        []AppRunnerOperation `json:"operations,omitempty"`
+	OrgID             string               `json:"org_id,omitempty"`
+	RunnerGroup       *AppRunnerGroup      `json:"runner_group,omitempty"`
+	RunnerGroupID     string               `json:"runner_group_id,omitempty"`
+	RunnerJob         *AppRunnerJob        `json:"runner_job,omitempty"`
+	Status            string               `json:"status,omitempty"`
+	StatusDescription string               `json:"status_description,omitempty"`
+	UpdatedAt         string               `json:"updated_at,omitempty"`
+}
+
+// AppRunnerGroup is app.RunnerGroup.
+type AppRunnerGroup struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	CreatedByID string `json:"created_by_id,omitempty"`
+	ID          string `json:"id,omitempty"`
+	OrgID       string `json:"org_id,omitempty"`
+	// parent can org, install or in the future, builtin runner group
+	OwnerID   string                  `json:"owner_id,omitempty"`
+	OwnerType string                  `json:"owner_type,omitempty"`
+	Platform  AppAppRunnerType        `json:"platform,omitempty"`
+	Runners   []AppRunner             `json:"runners,omitempty"`
+	Settings  *AppRunnerGroupSettings `json:"settings,omitempty"`
+	Type      AppRunnerGroupType      `json:"type,omitempty"`
+	UpdatedAt string                  `json:"updated_at,omitempty"`
+}
+
+// AppRunnerGroupSettings is app.RunnerGroupSettings.
+type AppRunnerGroupSettings struct {
+	AWSCloudformationStackType string `json:"aws_cloudformation_stack_type,omitempty"`
+	// aws runner specifics runner-v2
+	AWSInstanceType string `json:"aws_instance_type,omitempty"`
+	// Default: 7 days
+	AWSMaxInstanceLifetime int64             `json:"aws_max_instance_lifetime,omitempty"`
+	AWSTags                map[string]string `json:"aws_tags,omitempty"`
+	ContainerImageTag      string            `json:"container_image_tag,omitempty"`
+	// configuration for deploying the runner
+	ContainerImageURL string `json:"container_image_url,omitempty"`
+	CreatedAt         string `json:"created_at,omitempty"`
+	CreatedByID       string `json:"created_by_id,omitempty"`
+	EnableLogging     bool   `json:"enable_logging,omitempty"`
+	EnableMetrics     bool   `json:"enable_metrics,omitempty"`
+	EnableSentry      bool   `json:"enable_sentry,omitempty"`
+	// the job loop groups the runner should poll for
+	Groups []string `json:"groups,omitempty"`
+	// Various settings for the runner to handle internally
+	HeartBeatTimeout   int64  `json:"heart_beat_timeout,omitempty"`
+	ID                 string `json:"id,omitempty"`
+	LocalAWSIAMRoleARN string `json:"local_aws_iam_role_arn,omitempty"`
+	LoggingLevel       string `json:"logging_level,omitempty"`
+	// Metadata is used as both log and metric tags/attributes in the runner when emitting data
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// org runner specifics
+	OrgAWSIAMRoleARN         string `json:"org_aws_iam_role_arn,omitempty"`
+	OrgID                    string `json:"org_id,omitempty"`
+	OrgK8sServiceAccountName string `json:"org_k8s_service_account_name,omitempty"`
+	OtelCollectorConfig      string `json:"otel_collector_config,omitempty"`
+	// platform variable for use in the runner
+	Platform      string `json:"platform,omitempty"`
+	RunnerAPIURL  string `json:"runner_api_url,omitempty"`
+	RunnerGroupID string `json:"runner_group_id,omitempty"`
+	// configuration for managing the runner server side
+	SandboxMode bool   `json:"sandbox_mode,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// AppRunnerGroupType is app.RunnerGroupType.
+type AppRunnerGroupType string
+
+const (
+	AppRunnerGroupTypeInstall AppRunnerGroupType = "install"
+	AppRunnerGroupTypeOrg     AppRunnerGroupType = "org"
+)
+
+// AppRunnerHealthCheck is app.RunnerHealthCheck.
+type AppRunnerHealthCheck struct {
+	CreatedAt    string          `json:"created_at,omitempty"`
+	CreatedByID  string          `json:"created_by_id,omitempty"`
+	ID           string          `json:"id,omitempty"`
+	MinuteBucket string          `json:"minute_bucket,omitempty"`
+	Process      string          `json:"process,omitempty"`
+	RunnerID     string          `json:"runner_id,omitempty"`
+	RunnerJob    *AppRunnerJob   `json:"runner_job,omitempty"`
+	Status       AppRunnerStatus `json:"status,omitempty"`
+	StatusCode   int64           `json:"status_code,omitempty"`
+	UpdatedAt    string          `json:"updated_at,omitempty"`
+}
+
+// AppRunnerHeartBeat is app.RunnerHeartBeat.
+type AppRunnerHeartBeat struct {
+	AliveTime   int64  `json:"alive_time,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	CreatedByID string `json:"created_by_id,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Process     string `json:"process,omitempty"`
+	RunnerID    string `json:"runner_id,omitempty"`
+	StartedAt   string `json:"started_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	Version     string `json:"version,omitempty"`
+}
+
+// AppRunnerJob is app.RunnerJob.
+type AppRunnerJob struct {
+	// available timeout is how long a job can be marked as "available" before being requeued
+	AvailableTimeout int64  `json:"available_timeout,omitempty"`
+	CreatedAt        string `json:"created_at,omitempty"`
+	CreatedByID      string `json:"created_by_id,omitempty"`
+	ExecutionCount   int64  `json:"execution_count,omitempty"`
+	ExecutionTime    int64  `json:"execution_time,omitempty"`
+	// execution timeout is how long a job can be marked as "exeucuting" before being requeued
+	ExecutionTimeout          int64                     `json:"execution_timeout,omitempty"`
+	Executions                []AppRunnerJobExecution   `json:"executions,omitempty"`
+	FinalRunnerJobExecutionID string                    `json:"final_runner_job_execution_id,omitempty"`
+	FinishedAt                string                    `json:"finished_at,omitempty"`
+	Group                     AppRunnerJobGroup         `json:"group,omitempty"`
+	ID                        string                    `json:"id,omitempty"`
+	JSON                      *AppRunnerJobPlan         `json:"json,omitempty"`
+	LogStreamID               string                    `json:"log_stream_id,omitempty"`
+	MaxExecutions             int64                     `json:"max_executions,omitempty"`
+	Metadata                  map[string]string         `json:"metadata,omitempty"`
+	Operation                 AppRunnerJobOperationType `json:"operation,omitempty"`
+	OrgID                     string                    `json:"org_id,omitempty"`
+	Outputs                   map[string]any            `json:"outputs,omitempty"`
+	OutputsJSON               string                    `json:"outputs_json,omitempty"`
+	// overall timeout is how long a job can be attempted, before being cancelled
+	OverallTimeout int64  `json:"overall_timeout,omitempty"`
+	OwnerID        string `json:"owner_id,omitempty"`
+	OwnerType      string `json:"owner_type,omitempty"`
+	// queue timeout is how long a job can be queued, before being made available
+	QueueTimeout      int64              `json:"queue_timeout,omitempty"`
+	RunnerID          string             `json:"runner_id,omitempty"`
+	StartedAt         string             `json:"started_at,omitempty"`
+	Status            AppRunnerJobStatus `json:"status,omitempty"`
+	StatusDescription string             `json:"status_description,omitempty"`
+	Type              AppRunnerJobType   `json:"type,omitempty"`
+	UpdatedAt         string             `json:"updated_at,omitempty"`
+}
+
+// AppRunnerJobExecution is app.RunnerJobExecution.
+type AppRunnerJobExecution struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	CreatedByID string `json:"created_by_id,omitempty"`
+	ID          string `json:"id,omitempty"`
+	// Metadata is used to store additional information about the execution {e.g., client version.}
+	Metadata    map[string]string             `json:"metadata,omitempty"`
+	OrgID       string                        `json:"org_id,omitempty"`
+	Outputs     *AppRunnerJobExecutionOutputs `json:"outputs,omitempty"`
+	Result      *AppRunnerJobExecutionResult  `json:"result,omitempty"`
+	RunnerJobID string                        `json:"runner_job_id,omitempty"`
+	Status      AppRunnerJobExecutionStatus   `json:"status,omitempty"`
+	UpdatedAt   string                        `json:"updated_at,omitempty"`
+}
+
+// AppRunnerJobExecutionOutputs is app.RunnerJobExecutionOutputs.
+type AppRunnerJobExecutionOutputs struct {
+	CreatedAt            string                    `json:"created_at,omitempty"`
+	CreatedByID          string                    `json:"created_by_id,omitempty"`
+	ID                   string                    `json:"id,omitempty"`
+	OrgID                string                    `json:"org_id,omitempty"`
+	Outputs              map[string]map[string]any `json:"outputs,omitempty"`
+	OutputsJSON          string                    `json:"outputs_json,omitempty"`
+	RunnerJobExecutionID string                    `json:"runner_job_execution_id,omitempty"`
+	UpdatedAt            string                    `json:"updated_at,omitempty"`
+}
+
+// AppRunnerJobExecutionResult is app.RunnerJobExecutionResult.
+type AppRunnerJobExecutionResult struct {
+	Contents            string `json:"contents,omitempty"`
+	ContentsDisplay     string `json:"contents_display,omitempty"`
+	ContentsDisplayGzip string `json:"contents_display_gzip,omitempty"`
+	// columns for storage of gzipped contents and plans
+	ContentsGzip         string            `json:"contents_gzip,omitempty"`
+	CreatedAt            string            `json:"created_at,omitempty"`
+	CreatedByID          string            `json:"created_by_id,omitempty"`
+	ErrorCode            int64             `json:"error_code,omitempty"`
+	ErrorMetadata        map[string]string `json:"error_metadata,omitempty"`
+	ID                   string            `json:"id,omitempty"`
+	OrgID                string            `json:"org_id,omitempty"`
+	RunnerJobExecutionID string            `json:"runner_job_execution_id,omitempty"`
+	Success              bool              `json:"success,omitempty"`
+	UpdatedAt            string            `json:"updated_at,omitempty"`
+}
+
+// AppRunnerJobExecutionStatus is app.RunnerJobExecutionStatus.
+type AppRunnerJobExecutionStatus string
+
+const (
+	AppRunnerJobExecutionStatusPending      AppRunnerJobExecutionStatus = "pending"
+	AppRunnerJobExecutionStatusInitializing AppRunnerJobExecutionStatus = "initializing"
+	AppRunnerJobExecutionStatusInProgress   AppRunnerJobExecutionStatus = "in-progress"
+	AppRunnerJobExecutionStatusCleaningUp   AppRunnerJobExecutionStatus = "cleaning-up"
+	AppRunnerJobExecutionStatusFinished     AppRunnerJobExecutionStatus = "finished"
+	AppRunnerJobExecutionStatusFailed       AppRunnerJobExecutionStatus = "failed"
+	AppRunnerJobExecutionStatusTimedOut     AppRunnerJobExecutionStatus = "timed-out"
+	AppRunnerJobExecutionStatusNotAttempted AppRunnerJobExecutionStatus = "not-attempted"
+	AppRunnerJobExecutionStatusCancelled    AppRunnerJobExecutionStatus = "cancelled"
+	AppRunnerJobExecutionStatusUnknown      AppRunnerJobExecutionStatus = "unknown"
+)
+
+// AppRunnerJobGroup is app.RunnerJobGroup.
+type AppRunnerJobGroup string
+
+const (
+	AppRunnerJobGroupHealthChecks AppRunnerJobGroup = "health-checks"
+	AppRunnerJobGroupSync         AppRunnerJobGroup = "sync"
+	AppRunnerJobGroupBuild        AppRunnerJobGroup = "build"
+	AppRunnerJobGroupDeploy       AppRunnerJobGroup = "deploy"
+	AppRunnerJobGroupSandbox      AppRunnerJobGroup = "sandbox"
+	AppRunnerJobGroupRunner       AppRunnerJobGroup = "runner"
+	AppRunnerJobGroupOperations   AppRunnerJobGroup = "operations"
+	AppRunnerJobGroupManagement   AppRunnerJobGroup = "management"
+	AppRunnerJobGroupActions      AppRunnerJobGroup = "actions"
+	AppRunnerJobGroupAny          AppRunnerJobGroup = "any"
+)
+
+// AppRunnerJobOperationType is app.RunnerJobOperationType.
+type AppRunnerJobOperationType string
+
+const (
+	AppRunnerJobOperationTypeExec               AppRunnerJobOperationType = "exec"
+	AppRunnerJobOperationTypeBuild              AppRunnerJobOperationType = "build"
+	AppRunnerJobOperationTypeCreateApplyPlan    AppRunnerJobOperationType = "create-apply-plan"
+	AppRunnerJobOperationTypeCreateTeardownPlan AppRunnerJobOperationType = "create-teardown-plan"
+	AppRunnerJobOperationTypeApplyPlan          AppRunnerJobOperationType = "apply-plan"
+	AppRunnerJobOperationTypeUnknown            AppRunnerJobOperationType = "unknown"
+)
+
+// AppRunnerJobPlan is app.RunnerJobPlan.
+type AppRunnerJobPlan struct {
+	CompositePlan *PlantypesCompositePlan `json:"composite_plan,omitempty"`
+	CreatedAt     string                  `json:"created_at,omitempty"`
+	CreatedByID   string                  `json:"created_by_id,omitempty"`
+	ID            string                  `json:"id,omitempty"`
+	OrgID         string                  `json:"org_id,omitempty"`
+	PlanJSON      string                  `json:"plan_json,omitempty"`
+	RunnerJobID   string                  `json:"runner_job_id,omitempty"`
+	UpdatedAt     string                  `json:"updated_at,omitempty"`
+}
+
+// AppRunnerJobStatus is app.RunnerJobStatus.
+type AppRunnerJobStatus string
+
+const (
+	AppRunnerJobStatusQueued       AppRunnerJobStatus = "queued"
+	AppRunnerJobStatusAvailable    AppRunnerJobStatus = "available"
+	AppRunnerJobStatusInProgress   AppRunnerJobStatus = "in-progress"
+	AppRunnerJobStatusFinished     AppRunnerJobStatus = "finished"
+	AppRunnerJobStatusFailed       AppRunnerJobStatus = "failed"
+	AppRunnerJobStatusTimedOut     AppRunnerJobStatus = "timed-out"
+	AppRunnerJobStatusNotAttempted AppRunnerJobStatus = "not-attempted"
+	AppRunnerJobStatusCancelled    AppRunnerJobStatus = "cancelled"
+	AppRunnerJobStatusUnknown      AppRunnerJobStatus = "unknown"
+)
+
+// AppRunnerJobType is app.RunnerJobType.
+type AppRunnerJobType string
+
+const (
+	AppRunnerJobTypeHealthCheck              AppRunnerJobType = "health-check"
+	AppRunnerJobTypeDockerBuild              AppRunnerJobType = "docker-build"
+	AppRunnerJobTypeContainerImageBuild      AppRunnerJobType = "container-image-build"
+	AppRunnerJobTypeTerraformModuleBuild     AppRunnerJobType = "terraform-module-build"
+	AppRunnerJobTypeHelmChartBuild           AppRunnerJobType = "helm-chart-build"
+	AppRunnerJobTypeKubernetesManifestBuild  AppRunnerJobType = "kubernetes-manifest-build"
+	AppRunnerJobTypeNoopBuild                AppRunnerJobType = "noop-build"
+	AppRunnerJobTypeOCISync                  AppRunnerJobType = "oci-sync"
+	AppRunnerJobTypeNoopSync                 AppRunnerJobType = "noop-sync"
+	AppRunnerJobTypeFetchImageMetadata       AppRunnerJobType = "fetch-image-metadata"
+	AppRunnerJobTypeTerraformDeploy          AppRunnerJobType = "terraform-deploy"
+	AppRunnerJobTypeHelmChartDeploy          AppRunnerJobType = "helm-chart-deploy"
+	AppRunnerJobTypeJobDeploy                AppRunnerJobType = "job-deploy"
+	AppRunnerJobTypeKubernetesManifestDeploy AppRunnerJobType = "kubernetes-manifest-deploy"
+	AppRunnerJobTypeNoopDeploy               AppRunnerJobType = "noop-deploy"
+	AppRunnerJobTypeShutDown                 AppRunnerJobType = "shut-down"
+	AppRunnerJobTypeUpdateVersion            AppRunnerJobType = "update-version"
+	AppRunnerJobTypeNoop                     AppRunnerJobType = "noop"
+	AppRunnerJobTypeMngVmShutDown            AppRunnerJobType = "mng-vm-shut-down"
+	AppRunnerJobTypeMngShutDown              AppRunnerJobType = "mng-shut-down"
+	AppRunnerJobTypeMngRunnerUpdateVersion   AppRunnerJobType = "mng-runner-update-version"
+	AppRunnerJobTypeMngRunnerRestart         AppRunnerJobType = "mng-runner-restart"
+	AppRunnerJobTypeMngFetchToken            AppRunnerJobType = "mng-fetch-token"
+	AppRunnerJobTypeSandboxTerraform         AppRunnerJobType = "sandbox-terraform"
+	AppRunnerJobTypeSandboxTerraformPlan     AppRunnerJobType = "sandbox-terraform-plan"
+	AppRunnerJobTypeSandboxSyncSecrets       AppRunnerJobType = "sandbox-sync-secrets"
+	AppRunnerJobTypeRunnerHelm               AppRunnerJobType = "runner-helm"
+	AppRunnerJobTypeRunnerTerraform          AppRunnerJobType = "runner-terraform"
+	AppRunnerJobTypeRunnerLocal              AppRunnerJobType = "runner-local"
+	AppRunnerJobTypeActionsWorkflow          AppRunnerJobType = "actions-workflow"
+)
+
+// AppRunnerOperation is app.RunnerOperation.
+type AppRunnerOperation struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	CreatedByID string `json:"created_by_id,omitempty"`
+	ID          string `json:"id,omitempty"`
+	// job details
+	LogStream         *AppLogStream          `json:"log_stream,omitempty"`
+	OperationType     AppRunnerOperationType `json:"operation_type,omitempty"`
+	RunnerID          string                 `json:"runner_id,omitempty"`
+	Status            string                 `json:"status,omitempty"`
+	StatusDescription string                 `json:"status_description,omitempty"`
+	UpdatedAt         string                 `json:"updated_at,omitempty"`
+}
+
+// AppRunnerOperationType is app.RunnerOperationType.
+type AppRunnerOperationType string
+
+const (
+	AppRunnerOperationTypeProvision               AppRunnerOperationType = "provision"
+	AppRunnerOperationTypeProvisionServiceAccount AppRunnerOperationType = "provision_service_account"
+	AppRunnerOperationTypeReprovision             AppRunnerOperationType = "reprovision"
+	AppRunnerOperationTypeDeprovision             AppRunnerOperationType = "deprovision"
+)
+
+// AppRunnerStatus is app.RunnerStatus.
+type AppRunnerStatus string
+
+const (
+	AppRunnerStatusError                   AppRunnerStatus = "error"
+	AppRunnerStatusActive                  AppRunnerStatus = "active"
+	AppRunnerStatusPending                 AppRunnerStatus = "pending"
+	AppRunnerStatusProvisioning            AppRunnerStatus = "provisioning"
+	AppRunnerStatusDeprovisioning          AppRunnerStatus = "deprovisioning"
+	AppRunnerStatusDeprovisioned           AppRunnerStatus = "deprovisioned"
+	AppRunnerStatusReprovisioning          AppRunnerStatus = "reprovisioning"
+	AppRunnerStatusOffline                 AppRunnerStatus = "offline"
+	AppRunnerStatusAwaitingInstallStackRun AppRunnerStatus = "awaiting-install-stack-run"
+	AppRunnerStatusUnknown                 AppRunnerStatus = "unknown"
+)
+
+// AppSandboxRunType is app.SandboxRunType.
+type AppSandboxRunType string
+
+const (
+	AppSandboxRunTypeProvision   AppSandboxRunType = "provision"
+	AppSandboxRunTypeReprovision AppSandboxRunType = "reprovision"
+	AppSandboxRunTypeDeprovision AppSandboxRunType = "deprovision"
+)
+
+// AppStackType is app.StackType.
+type AppStackType string
+
+const (
+	AppStackTypeAWSCloudformation AppStackType = "aws-cloudformation"
+)
+
+// AppStatus is app.Status.
+type AppStatus string
+
+const (
+	AppStatusError            AppStatus = "error"
+	AppStatusPending          AppStatus = "pending"
+	AppStatusInProgress       AppStatus = "in-progress"
+	AppStatusCheckingPlan     AppStatus = "checking-plan"
+	AppStatusSuccess          AppStatus = "success"
+	AppStatusNotAttempted     AppStatus = "not-attempted"
+	AppStatusCancelled        AppStatus = "cancelled"
+	AppStatusRetrying         AppStatus = "retrying"
+	AppStatusDiscarded        AppStatus = "discarded"
+	AppStatusUserSkipped      AppStatus = "user-skipped"
+	AppStatusAutoSkipped      AppStatus = "auto-skipped"
+	AppStatusPlanning         AppStatus = "planning"
+	AppStatusApplying         AppStatus = "applying"
+	AppStatusQueued           AppStatus = "queued"
+	AppStatusWarning          AppStatus = "warning"
+	AppStatusGenerating       AppStatus = "generating"
+	AppStatusAwaitingUserRun  AppStatus = "awaiting-user-run"
+	AppStatusProvisioning     AppStatus = "provisioning"
+	AppStatusActive           AppStatus = "active"
+	AppStatusOutdated         AppStatus = "outdated"
+	AppStatusExpired          AppStatus = "expired"
+	AppStatusApproved         AppStatus = "approved"
+	AppStatusDrifted          AppStatus = "drifted"
+	AppStatusNoDrift          AppStatus = "no-drift"
+	AppStatusApprovalExpired  AppStatus = "approval-expired"
+	AppStatusApprovalDenied   AppStatus = "approval-denied"
+	AppStatusApprovalRetry    AppStatus = "approval-retry"
+	AppStatusBuilding         AppStatus = "building"
+	AppStatusDeleting         AppStatus = "deleting"
+	AppStatusNoop             AppStatus = "noop"
+	AppStatusApprovalAwaiting AppStatus = "approval-awaiting"
+)
+
+// AppTerraformLock is app.TerraformLock.
+type AppTerraformLock struct {
+	Created   string `json:"created,omitempty"`
+	ID        string `json:"id,omitempty"`
+	Info      string `json:"info,omitempty"`
+	Operation string `json:"operation,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Version   any    `json:"version,omitempty"`
+	Who       string `json:"who,omitempty"`
+}
+
+// AppTerraformModuleComponentConfig is app.TerraformModuleComponentConfig.
+type AppTerraformModuleComponentConfig struct {
+	// parent reference
+	ComponentConfigConnectionID string                       `json:"component_config_connection_id,omitempty"`
+	ConnectedGithubVCSConfig    *AppConnectedGithubVCSConfig `json:"connected_github_vcs_config,omitempty"`
+	CreatedAt                   string                       `json:"created_at,omitempty"`
+	CreatedByID                 string                       `json:"created_by_id,omitempty"`
+	EnvVars                     map[string]string            `json:"env_vars,omitempty"`
+	ID                          string                       `json:"id,omitempty"`
+	PublicGitVCSConfig          *AppPublicGitVCSConfig       `json:"public_git_vcs_config,omitempty"`
+	UpdatedAt                   string                       `json:"updated_at,omitempty"`
+	Variables                   map[string]string            `json:"variables,omitempty"`
+	VariablesFiles              []string                     `json:"variables_files,omitempty"`
+	// terraform configuration values
+	Version string `json:"version,omitempty"`
+}
+
+// AppTerraformStateInstance is app.TerraformStateInstance.
+type AppTerraformStateInstance struct {
+	Attributes          map[string]any `json:"attributes,omitempty"`
+	SchemaVersion       int64          `json:"schema_version,omitempty"`
+	SensitiveAttributes []any          `json:"sensitive_attributes,omitempty"`
+}
+
+// AppTerraformStateResource is app.TerraformStateResource.
+type AppTerraformStateResource struct {
+	Instances []AppTerraformStateInstance `json:"instances,omitempty"`
+	Mode      string                      `json:"mode,omitempty"`
+	Name      string                      `json:"name,omitempty"`
+	Provider  string                      `json:"provider,omitempty"`
+	Type      string                      `json:"type,omitempty"`
+}
+
+// AppTerraformWorkspace is app.TerraformWorkspace.
+type AppTerraformWorkspace struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	CreatedByID string `json:"created_by_id,omitempty"`
+	ID          string `json:"id,omitempty"`
+	OrgID       string `json:"org_id,omitempty"`
+	OwnerID     string `json:"owner_id,omitempty"`
+	OwnerType   string `json:"owner_type,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// AppTerraformWorkspaceLock is app.TerraformWorkspaceLock.
+type AppTerraformWorkspaceLock struct {
+	CreatedAt   string            `json:"created_at,omitempty"`
+	CreatedByID string            `json:"created_by_id,omitempty"`
+	ID          string            `json:"id,omitempty"`
+	Lock        *AppTerraformLock `json:"lock,omitempty"`
+	OrgID       string            `json:"org_id,omitempty"`
+	RunnerJob   *AppRunnerJob     `json:"runner_job,omitempty"`
+	RunnerJobID string            `json:"runner_job_id,omitempty"`
+	UpdatedAt   string            `json:"updated_at,omitempty"`
+	// Foreign key to TerraformWorkspace with unique constraint to prevent multiple active locks
+	WorkspaceID string `json:"workspace_id,omitempty"`
+}
+
+// AppTerraformWorkspaceState is app.TerraformWorkspaceState.
+type AppTerraformWorkspaceState struct {
+	Contents             []int64                `json:"contents,omitempty"`
+	CreatedAt            string                 `json:"created_at,omitempty"`
+	CreatedByID          string                 `json:"created_by_id,omitempty"`
+	ID                   string                 `json:"id,omitempty"`
+	OrgID                string                 `json:"org_id,omitempty"`
+	Revision             int64                  `json:"revision,omitempty"`
+	RunnerJob            *AppRunnerJob          `json:"runner_job,omitempty"`
+	RunnerJobID          string                 `json:"runner_job_id,omitempty"`
+	TerraformWorkspace   *AppTerraformWorkspace `json:"terraform_workspace,omitempty"`
+	TerraformWorkspaceID string                 `json:"terraform_workspace_id,omitempty"`
+	UpdatedAt            string                 `json:"updated_at,omitempty"`
+}
+
+// AppTerraformWorkspaceStateJSON is app.TerraformWorkspaceStateJSON.
+type AppTerraformWorkspaceStateJSON struct {
+	Contents    []int64       `json:"contents,omitempty"`
+	CreatedAt   string        `json:"created_at,omitempty"`
+	CreatedByID string        `json:"created_by_id,omitempty"`
+	ID          string        `json:"id,omitempty"`
+	OrgID       string        `json:"org_id,omitempty"`
+	RunnerJob   *AppRunnerJob `json:"runner_job,omitempty"`
+	RunnerJobID string        `json:"runner_job_id,omitempty"`
+	UpdatedAt   string        `json:"updated_at,omitempty"`
+	// Foreign key to TerraformWorkspace with unique constraint to prevent conflicting states for a workspace
+	WorkspaceID string `json:"workspace_id,omitempty"`
+}
+
+// AppUserJourney is app.UserJourney.
+type AppUserJourney struct {
+	Name  string               `json:"name,omitempty"`
+	Steps []AppUserJourneyStep `json:"steps,omitempty"`
+	Title string               `json:"title,omitempty"`
+}
+
+// AppUserJourneyStep is app.UserJourneyStep.
+type AppUserJourneyStep struct {
+	Complete bool `json:"complete,omitempty"`
+	// Top-level completion tracking fields
+	CompletedAt      string `json:"completed_at,omitempty"`
+	CompletionMethod string `json:"completion_method,omitempty"`
+	CompletionSource string `json:"completion_source,omitempty"`
+	// Flexible metadata for business data
+	Metadata map[string]any `json:"metadata,omitempty"`
+	Name     string         `json:"name,omitempty"`
+	Title    string         `json:"title,omitempty"`
+}
+
+// AppVCSConnection is app.VCSConnection.
+type AppVCSConnection struct {
+	CreatedAt           string                   `json:"created_at,omitempty"`
+	CreatedByID         string                   `json:"created_by_id,omitempty"`
+	GithubAccountID     string                   `json:"github_account_id,omitempty"`
+	GithubAccountName   string                   `json:"github_account_name,omitempty"`
+	GithubInstallID     string                   `json:"github_install_id,omitempty"`
+	ID                  string                   `json:"id,omitempty"`
+	UpdatedAt           string                   `json:"updated_at,omitempty"`
+	VCSConnectionCommit []AppVCSConnectionCommit `json:"vcs_connection_commit,omitempty"`
+}
+
+// AppVCSConnectionCommit is app.VCSConnectionCommit.
+type AppVCSConnectionCommit struct {
+	AuthorEmail     string `json:"author_email,omitempty"`
+	AuthorName      string `json:"author_name,omitempty"`
+	CreatedAt       string `json:"created_at,omitempty"`
+	CreatedByID     string `json:"created_by_id,omitempty"`
+	ID              string `json:"id,omitempty"`
+	Message         string `json:"message,omitempty"`
+	Sha             string `json:"sha,omitempty"`
+	UpdatedAt       string `json:"updated_at,omitempty"`
+	VCSConnectionID string `json:"vcs_connection_id,omitempty"`
+}
+
+// AppWaitlist is app.Waitlist.
+type AppWaitlist struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	CreatedByID string `json:"created_by_id,omitempty"`
+	ID          string `json:"id,omitempty"`
+	OrgName     string `json:"org_name,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// AppWorkflow is app.Workflow.
+type AppWorkflow struct {
+	ApprovalOption            AppInstallApprovalOption      `json:"approval_option,omitempty"`
+	CreatedAt                 string                        `json:"created_at,omitempty"`
+	CreatedBy                 *AppAccount                   `json:"created_by,omitempty"`
+	CreatedByID               string                        `json:"created_by_id,omitempty"`
+	ExecutionTime             int64                         `json:"execution_time,omitempty"`
+	Finished                  bool                          `json:"finished,omitempty"`
+	FinishedAt                string                        `json:"finished_at,omitempty"`
+	ID                        string                        `json:"id,omitempty"`
+	InstallActionWorkflowRuns []AppInstallActionWorkflowRun `json:"install_action_workflow_runs,omitempty"`
+	InstallDeploys            []AppInstallDeploy            `json:"install_deploys,omitempty"`
+	InstallSandboxRuns        []AppInstallSandboxRun        `json:"install_sandbox_runs,omitempty"`
+	Links                     map[string]any                `json:"links,omitempty"`
+	Metadata                  map[string]string             `json:"metadata,omitempty"`
+	Name                      string                        `json:"name,omitempty"`
+	OwnerID                   string                        `json:"owner_id,omitempty"`
+	OwnerType                 string                        `json:"owner_type,omitempty"`
+	PlanOnly                  bool                          `json:"plan_only,omitempty"`
+	Role                      string                        `json:"role,omitempty"`
+	StartedAt                 string                        `json:"started_at,omitempty"`
+	Status                    *AppCompositeStatus           `json:"status,omitempty"`
+	// DEPRECATED: for now we always abort on step errors
+	StepErrorBehavior string `json:"step_error_behavior,omitempty"`
+	// steps represent each piece of the workflow
+	Steps     []AppWorkflowStep `json:"steps,omitempty"`
+	Type      AppWorkflowType   `json:"type,omitempty"`
+	UpdatedAt string            `json:"updated_at,omitempty"`
+}
+
+// AppWorkflowStep is app.WorkflowStep.
+type AppWorkflowStep struct {
+	Approval      *AppWorkflowStepApproval     `json:"approval,omitempty"`
+	CreatedAt     string                       `json:"created_at,omitempty"`
+	CreatedBy     *AppAccount                  `json:"created_by,omitempty"`
+	CreatedByID   string                       `json:"created_by_id,omitempty"`
+	ExecutionTime int64                        `json:"execution_time,omitempty"`
+	ExecutionType AppWorkflowStepExecutionType `json:"execution_type,omitempty"`
+	Finished      bool                         `json:"finished,omitempty"`
+	FinishedAt    string                       `json:"finished_at,omitempty"`
+	// to group steps which belong to same logical group, eg, plan/apply
+	GroupIdx int64 `json:"group_idx,omitempty"`
+	// counter for every retry attempted on a group
+	GroupRetryIdx int64  `json:"group_retry_idx,omitempty"`
+	ID            string `json:"id,omitempty"`
+	Idx           int64  `json:"idx,omitempty"`
+	// DEPRECATED: this is the install workflow ID, which is now the workflow ID.
+	InstallWorkflowID string                           `json:"install_workflow_id,omitempty"`
+	Links             map[string]any                   `json:"links,omitempty"`
+	Metadata          map[string]string                `json:"metadata,omitempty"`
+	Name              string                           `json:"name,omitempty"`
+	OwnerID           string                           `json:"owner_id,omitempty"`
+	OwnerType         string                           `json:"owner_type,omitempty"`
+	PolicyValidation  *AppWorkflowStepPolicyValidation `json:"policy_validation,omitempty"`
+	Retried           bool                             `json:"retried,omitempty"`
+	Retryable         bool                             `json:"retryable,omitempty"`
+	Skippable         bool                             `json:"skippable,omitempty"`
+	StartedAt         string                           `json:"started_at,omitempty"`
+	Status            *AppCompositeStatus              `json:"status,omitempty"`
+	// the following fields are set _once_ a step is in flight, and are orchestrated via the step's signal.
+	//
+	// this is a polymorphic gorm relationship to one of the following objects:
+	//
+	// install_cloudformation_stack
+	// install_sandbox_run
+	// install_runner_update
+	// install_deploy
+	// install_action_workflow_run (can be many of these)
+	StepTargetID   string `json:"step_target_id,omitempty"`
+	StepTargetType string `json:"step_target_type,omitempty"`
+	UpdatedAt      string `json:"updated_at,omitempty"`
+	// Fields that are de-nested at read time using AfterQuery
+	WorkflowID string `json:"workflow_id,omitempty"`
+}
+
+// AppWorkflowStepApproval is app.WorkflowStepApproval.
+type AppWorkflowStepApproval struct {
+	CreatedAt           string           `json:"created_at,omitempty"`
+	CreatedByID         string           `json:"created_by_id,omitempty"`
+	ID                  string           `json:"id,omitempty"`
+	InstallWorkflowStep *AppWorkflowStep `json:"installWorkflowStep,omitempty"`
+	// the step that this approval belongs too
+	InstallWorkflowStepID string            `json:"installWorkflowStepID,omitempty"`
+	OwnerID               string            `json:"owner_id,omitempty"`
+	OwnerType             string            `json:"owner_type,omitempty"`
+	Response              map[string]string `json:"response,omitempty"`
+	RunnerJob             *AppRunnerJob     `json:"runner_job,omitempty"`
+	// the runner job where this approval was created
+	RunnerJobID  string                      `json:"runner_job_id,omitempty"`
+	Type         AppWorkflowStepApprovalType `json:"type,omitempty"`
+	UpdatedAt    string                      `json:"updated_at,omitempty"`
+	WorkflowStep *AppWorkflowStep            `json:"workflow_step,omitempty"`
+	// afterquery
+	WorkflowStepID string `json:"workflow_step_id,omitempty"`
+}
+
+// AppWorkflowStepApprovalType is app.WorkflowStepApprovalType.
+type AppWorkflowStepApprovalType string
+
+const (
+	AppWorkflowStepApprovalTypeNoop                       AppWorkflowStepApprovalType = "noop"
+	AppWorkflowStepApprovalTypeApproveAll                 AppWorkflowStepApprovalType = "approve-all"
+	AppWorkflowStepApprovalTypeTerraformPlan              AppWorkflowStepApprovalType = "terraform_plan"
+	AppWorkflowStepApprovalTypeKubernetesManifestApproval AppWorkflowStepApprovalType = "kubernetes_manifest_approval"
+	AppWorkflowStepApprovalTypeHelmApproval               AppWorkflowStepApprovalType = "helm_approval"
+)
+
+// AppWorkflowStepExecutionType is app.WorkflowStepExecutionType.
+type AppWorkflowStepExecutionType string
+
+const (
+	AppWorkflowStepExecutionTypeSystem   AppWorkflowStepExecutionType = "system"
+	AppWorkflowStepExecutionTypeUser     AppWorkflowStepExecutionType = "user"
+	AppWorkflowStepExecutionTypeApproval AppWorkflowStepExecutionType = "approval"
+	AppWorkflowStepExecutionTypeSkipped  AppWorkflowStepExecutionType = "skipped"
+	AppWorkflowStepExecutionTypeHidden   AppWorkflowStepExecutionType = "hidden"
+)
+
+// AppWorkflowStepPolicyValidation is app.WorkflowStepPolicyValidation.
+type AppWorkflowStepPolicyValidation struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	CreatedByID string `json:"created_by_id,omitempty"`
+	ID          string `json:"id,omitempty"`
+	// install workflow step is the install step that this was performed within
+	InstallWorkflowStepID string `json:"install_workflow_step_id,omitempty"`
+	// response is the kyverno response (deprecated: use Reports for detailed results)
+	Response string `json:"response,omitempty"`
+	// runnerJobID is the runner job that this was performed within
+	RunnerJobID string `json:"runner_job_id,omitempty"`
+	// status denotes whether this passed, or whether it failed the job.
+	Status    *AppCompositeStatus `json:"status,omitempty"`
+	UpdatedAt string              `json:"updated_at,omitempty"`
+}
+
+// AppWorkflowStepResponseType is app.WorkflowStepResponseType.
+type AppWorkflowStepResponseType string
+
+const (
+	AppWorkflowStepResponseTypeDeny                         AppWorkflowStepResponseType = "deny"
+	AppWorkflowStepResponseTypeApprove                      AppWorkflowStepResponseType = "approve"
+	AppWorkflowStepResponseTypeDenySkipCurrent              AppWorkflowStepResponseType = "deny-skip-current"
+	AppWorkflowStepResponseTypeDenySkipCurrentAndDependents AppWorkflowStepResponseType = "deny-skip-current-and-dependents"
+	AppWorkflowStepResponseTypeRetry                        AppWorkflowStepResponseType = "retry"
+	AppWorkflowStepResponseTypeAutoApprove                  AppWorkflowStepResponseType = "auto-approve"
+)
+
+// AppWorkflowType is app.WorkflowType.
+type AppWorkflowType string
+
+const (
+	AppWorkflowTypeProvision                      AppWorkflowType = "provision"
+	AppWorkflowTypeDeprovision                    AppWorkflowType = "deprovision"
+	AppWorkflowTypeDeprovisionSandbox             AppWorkflowType = "deprovision_sandbox"
+	AppWorkflowTypeManualDeploy                   AppWorkflowType = "manual_deploy"
+	AppWorkflowTypeInputUpdate                    AppWorkflowType = "input_update"
+	AppWorkflowTypeDeployComponents               AppWorkflowType = "deploy_components"
+	AppWorkflowTypeTeardownComponent              AppWorkflowType = "teardown_component"
+	AppWorkflowTypeTeardownComponents             AppWorkflowType = "teardown_components"
+	AppWorkflowTypeReprovisionSandbox             AppWorkflowType = "reprovision_sandbox"
+	AppWorkflowTypeDriftRunReprovisionSandbox     AppWorkflowType = "drift_run_reprovision_sandbox"
+	AppWorkflowTypeActionWorkflowRun              AppWorkflowType = "action_workflow_run"
+	AppWorkflowTypeSyncSecrets                    AppWorkflowType = "sync_secrets"
+	AppWorkflowTypeDriftRun                       AppWorkflowType = "drift_run"
+	AppWorkflowTypeAppBranchesManualUpdate        AppWorkflowType = "app_branches_manual_update"
+	AppWorkflowTypeAppBranchesConfigRepoUpdate    AppWorkflowType = "app_branches_config_repo_update"
+	AppWorkflowTypeAppBranchesComponentRepoUpdate AppWorkflowType = "app_branches_component_repo_update"
+	AppWorkflowTypeReprovision                    AppWorkflowType = "reprovision"
+)
+
+// AzureCredentialsConfig is github_com_nuonco_nuon_pkg_azure_credentials.Config.
+type AzureCredentialsConfig struct {
+	ServicePrincipal *CredentialsServicePrincipalCredentials `json:"service_principal,omitempty"`
+	UseDefault       bool                                    `json:"use_default,omitempty"`
+}
+
+// ConfigAppPolicyEngine is config.AppPolicyEngine.
+type ConfigAppPolicyEngine string
+
+const (
+	ConfigAppPolicyEngineKyverno ConfigAppPolicyEngine = "kyverno"
+	ConfigAppPolicyEngineOpa     ConfigAppPolicyEngine = "opa"
+)
+
+// ConfigAppPolicyType is config.AppPolicyType.
+type ConfigAppPolicyType string
+
+const (
+	ConfigAppPolicyTypeKubernetesCluster  ConfigAppPolicyType = "kubernetes_cluster"
+	ConfigAppPolicyTypeTerraformModule    ConfigAppPolicyType = "terraform_module"
+	ConfigAppPolicyTypeHelmChart          ConfigAppPolicyType = "helm_chart"
+	ConfigAppPolicyTypeKubernetesManifest ConfigAppPolicyType = "kubernetes_manifest"
+	ConfigAppPolicyTypeDockerBuild        ConfigAppPolicyType = "docker_build"
+	ConfigAppPolicyTypeContainerImage     ConfigAppPolicyType = "container_image"
+	ConfigAppPolicyTypeSandbox            ConfigAppPolicyType = "sandbox"
+)
+
+// ConfigCustomNestedStack is config.CustomNestedStack.
+type ConfigCustomNestedStack struct {
+	Contents     string            `json:"contents,omitempty"`
+	ContentsHash string            `json:"contents_hash,omitempty"`
+	Index        int64             `json:"index,omitempty"`
+	Name         string            `json:"name,omitempty"`
+	Parameters   map[string]string `json:"parameters,omitempty"`
+	TemplateURL  string            `json:"template_url,omitempty"`
+}
+
+// ConfigHelmRepoConfig is config.HelmRepoConfig.
+type ConfigHelmRepoConfig struct {
+	Chart   string `json:"chart,omitempty"`
+	RepoURL string `json:"repoURL,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// ConfigsOCIRegistryAuth is configs.OCIRegistryAuth.
+type ConfigsOCIRegistryAuth struct {
+	Password string `json:"password,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// ConfigsOCIRegistryRepository is configs.OCIRegistryRepository.
+type ConfigsOCIRegistryRepository struct {
+	Acrauth      *AzureCredentialsConfig `json:"acrauth,omitempty"`
+	Ecrauth      *AWSCredentialsConfig   `json:"ecrauth,omitempty"`
+	LoginServer  string                  `json:"loginServer,omitempty"`
+	Ociauth      *ConfigsOCIRegistryAuth `json:"ociauth,omitempty"`
+	Plugin       string                  `json:"plugin,omitempty"`
+	Region       string                  `json:"region,omitempty"`
+	RegistryType ConfigsOCIRegistryType  `json:"registryType,omitempty"`
+	// based on the type of access, either the repository (ecr) or login server (acr) will be provided.
+	Repository string `json:"repository,omitempty"`
+}
+
+// ConfigsOCIRegistryType is configs.OCIRegistryType.
+type ConfigsOCIRegistryType string
+
+const (
+	ConfigsOCIRegistryTypeEcr        ConfigsOCIRegistryType = "ecr"
+	ConfigsOCIRegistryTypeAcr        ConfigsOCIRegistryType = "acr"
+	ConfigsOCIRegistryTypePrivateOCI ConfigsOCIRegistryType = "private_oci"
+	ConfigsOCIRegistryTypePublicOCI  ConfigsOCIRegistryType = "public_oci"
+)
+
+// CredentialsAssumeRoleConfig is credentials.AssumeRoleConfig.
+type CredentialsAssumeRoleConfig struct {
+	RoleARN                string `json:"role_arn"`
+	SessionDurationSeconds int64  `json:"session_duration_seconds,omitempty"`
+	SessionName            string `json:"session_name"`
+	// configuration for two stepping before assuming this role
+	TwoStepConfig *IAMTwoStepConfig `json:"two_step_config,omitempty"`
+	UseGithubOidc bool              `json:"use_github_oidc,omitempty"`
+}
+
+// CredentialsServicePrincipalCredentials is credentials.ServicePrincipalCredentials.
+type CredentialsServicePrincipalCredentials struct {
+	SubscriptionID       string `json:"subscription_id,omitempty"`
+	SubscriptionTenantID string `json:"subscription_tenant_id,omitempty"`
+}
+
+// CredentialsStaticCredentials is credentials.StaticCredentials.
+type CredentialsStaticCredentials struct {
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+	SessionToken    string `json:"session_token"`
+}
+
+// GenericsNullTime is generics.NullTime.
+type GenericsNullTime struct {
+	Time string `json:"time,omitempty"`
+	// Valid is true if Time is not NULL
+	Valid bool `json:"valid,omitempty"`
+}
+
+// GithubMatch is github.Match.
+type GithubMatch struct {
+	Indices []int64 `json:"indices,omitempty"`
+	Text    string  `json:"text,omitempty"`
+}
+
+// GithubPlan is github.Plan.
+type GithubPlan struct {
+	Collaborators int64  `json:"collaborators,omitempty"`
+	FilledSeats   int64  `json:"filled_seats,omitempty"`
+	Name          string `json:"name,omitempty"`
+	PrivateRepos  int64  `json:"private_repos,omitempty"`
+	Seats         int64  `json:"seats,omitempty"`
+	Space         int64  `json:"space,omitempty"`
+}
+
+// GithubTextMatch is github.TextMatch.
+type GithubTextMatch struct {
+	Fragment   string        `json:"fragment,omitempty"`
+	Matches    []GithubMatch `json:"matches,omitempty"`
+	ObjectType string        `json:"object_type,omitempty"`
+	ObjectURL  string        `json:"object_url,omitempty"`
+	Property   string        `json:"property,omitempty"`
+}
+
+// GithubTimestamp is github.Timestamp.
+type GithubTimestamp struct {
+	TimeTime string `json:"time.Time,omitempty"`
+}
+
+// GithubUser is github.User.
+type GithubUser struct {
+	AvatarURL         string           `json:"avatar_url,omitempty"`
+	Bio               string           `json:"bio,omitempty"`
+	Blog              string           `json:"blog,omitempty"`
+	Collaborators     int64            `json:"collaborators,omitempty"`
+	Company           string           `json:"company,omitempty"`
+	CreatedAt         *GithubTimestamp `json:"created_at,omitempty"`
+	DiskUsage         int64            `json:"disk_usage,omitempty"`
+	Email             string           `json:"email,omitempty"`
+	EventsURL         string           `json:"events_url,omitempty"`
+	Followers         int64            `json:"followers,omitempty"`
+	FollowersURL      string           `json:"followers_url,omitempty"`
+	Following         int64            `json:"following,omitempty"`
+	FollowingURL      string           `json:"following_url,omitempty"`
+	GistsURL          string           `json:"gists_url,omitempty"`
+	GravatarID        string           `json:"gravatar_id,omitempty"`
+	Hireable          bool             `json:"hireable,omitempty"`
+	HtmlURL           string           `json:"html_url,omitempty"`
+	ID                int64            `json:"id,omitempty"`
+	LdapDn            string           `json:"ldap_dn,omitempty"`
+	Location          string           `json:"location,omitempty"`
+	Login             string           `json:"login,omitempty"`
+	Name              string           `json:"name,omitempty"`
+	NodeID            string           `json:"node_id,omitempty"`
+	OrganizationsURL  string           `json:"organizations_url,omitempty"`
+	OwnedPrivateRepos int64            `json:"owned_private_repos,omitempty"`
+	// Permissions and RoleName identify the permissions and role that a user has on a given
+	// repository. These are only populated when calling Repositories.ListCollaborators.
+	Permissions       map[string]bool  `json:"permissions,omitempty"`
+	Plan              *GithubPlan      `json:"plan,omitempty"`
+	PrivateGists      int64            `json:"private_gists,omitempty"`
+	PublicGists       int64            `json:"public_gists,omitempty"`
+	PublicRepos       int64            `json:"public_repos,omitempty"`
+	ReceivedEventsURL string           `json:"received_events_url,omitempty"`
+	ReposURL          string           `json:"repos_url,omitempty"`
+	RoleName          string           `json:"role_name,omitempty"`
+	SiteAdmin         bool             `json:"site_admin,omitempty"`
+	StarredURL        string           `json:"starred_url,omitempty"`
+	SubscriptionsURL  string           `json:"subscriptions_url,omitempty"`
+	SuspendedAt       *GithubTimestamp `json:"suspended_at,omitempty"`
+	// TextMatches is only populated from search results that request text matches
+	// See: search.go and https://docs.github.com/en/rest/search/#text-match-metadata
+	TextMatches             []GithubTextMatch `json:"text_matches,omitempty"`
+	TotalPrivateRepos       int64             `json:"total_private_repos,omitempty"`
+	TwitterUsername         string            `json:"twitter_username,omitempty"`
+	TwoFactorAuthentication bool              `json:"two_factor_authentication,omitempty"`
+	Type                    string            `json:"type,omitempty"`
+	UpdatedAt               *GithubTimestamp  `json:"updated_at,omitempty"`
+	// API URLs
+	URL string `json:"url,omitempty"`
+}
+
+// HelpersCreateInstallConfigParams is helpers.CreateInstallConfigParams.
+type HelpersCreateInstallConfigParams struct {
+	ApprovalOption AppInstallApprovalOption `json:"approval_option,omitempty"`
+}
+
+// HelpersInstallMetadata is helpers.InstallMetadata.
+type HelpersInstallMetadata struct {
+	ManagedBy string `json:"managed_by,omitempty"`
+}
+
+// IAMStaticCredentials is iam.StaticCredentials.
+type IAMStaticCredentials struct {
+	AccessKeyID     string `json:"access_key_id,omitempty"`
+	SecretAccessKey string `json:"secret_access_key,omitempty"`
+	SessionToken    string `json:"session_token,omitempty"`
+}
+
+// IAMTwoStepConfig is iam.TwoStepConfig.
+type IAMTwoStepConfig struct {
+	IAMRoleARN    string `json:"iam_role_arn,omitempty"`
+	SrcIAMRoleARN string `json:"src_iam_role_arn,omitempty"`
+	// Root Credentials
+	SrcStaticCredentials *IAMStaticCredentials `json:"src_static_credentials,omitempty"`
+}
+
+// KubeClusterInfo is kube.ClusterInfo.
+type KubeClusterInfo struct {
+	// If either an AWS auth or Azure auth is passed in, we will automatically use it to resolve credentials and set
+	// them in the environment.
+	AWSAuth   *AWSCredentialsConfig   `json:"aws_auth,omitempty"`
+	AzureAuth *AzureCredentialsConfig `json:"azure_auth,omitempty"`
+	// CAData is the base64 encoded public certificate
+	CaData string `json:"ca_data,omitempty"`
+	// Endpoint is the URL of the k8s api server
+	Endpoint string            `json:"endpoint,omitempty"`
+	EnvVars  map[string]string `json:"env_vars,omitempty"`
+	// ID is the ID of the EKS cluster
+	ID string `json:"id,omitempty"`
+	// If this is set, we will _not_ use aws-iam-authenticator, but rather inline create the token
+	Inline bool `json:"inline,omitempty"`
+	// KubeConfig will override the kube config, and be parsed instead of generating a new one
+	KubeConfig string `json:"kube_config,omitempty"`
+	// TrustedRoleARN is the arn of the role that should be assumed to interact with the cluster
+	// NOTE(JM): we are deprecating this
+	TrustedRoleARN string `json:"trusted_role_arn,omitempty"`
+}
+
+// OutputsSecretSyncOutput is outputs.SecretSyncOutput.
+type OutputsSecretSyncOutput struct {
+	ARN                 string `json:"arn,omitempty"`
+	Exists              bool   `json:"exists,omitempty"`
+	KubernetesKey       string `json:"kubernetes_key,omitempty"`
+	KubernetesName      string `json:"kubernetes_name,omitempty"`
+	KubernetesNamespace string `json:"kubernetes_namespace,omitempty"`
+	Length              int64  `json:"length,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Timestamp           string `json:"timestamp,omitempty"`
+}
+
+// PermissionsPermission is permissions.Permission.
+type PermissionsPermission string
+
+const (
+	PermissionsPermissionUnknown PermissionsPermission = "unknown"
+	PermissionsPermissionAll     PermissionsPermission = "all"
+	PermissionsPermissionCreate  PermissionsPermission = "create"
+	PermissionsPermissionRead    PermissionsPermission = "read"
+	PermissionsPermissionUpdate  PermissionsPermission = "update"
+	PermissionsPermissionDelete  PermissionsPermission = "delete"
+)
+
+// PermissionsSet is permissions.Set.
+type PermissionsSet map[string]PermissionsPermission
+
+// PlantypesActionWorkflowRunPlan is plantypes.ActionWorkflowRunPlan.
+type PlantypesActionWorkflowRunPlan struct {
+	Attrs          map[string]string     `json:"attrs,omitempty"`
+	AWSAuth        *AWSCredentialsConfig `json:"aws_auth,omitempty"`
+	BuiltinEnvVars map[string]string     `json:"builtin_env_vars,omitempty"`
+	// optional fields based on the configuration
+	ClusterInfo     *KubeClusterInfo                     `json:"cluster_info,omitempty"`
+	ID              string                               `json:"id,omitempty"`
+	InstallID       string                               `json:"install_id,omitempty"`
+	OverrideEnvVars map[string]string                    `json:"override_env_vars,omitempty"`
+	SandboxMode     *PlantypesSandboxMode                `json:"sandbox_mode,omitempty"`
+	Steps           []PlantypesActionWorkflowRunStepPlan `json:"steps,omitempty"`
+}
+
+// PlantypesActionWorkflowRunStepPlan is plantypes.ActionWorkflowRunStepPlan.
+type PlantypesActionWorkflowRunStepPlan struct {
+	Attrs                      map[string]string   `json:"attrs,omitempty"`
+	GitSource                  *PlantypesGitSource `json:"git_source,omitempty"`
+	InterpolatedCommand        string              `json:"interpolated_command,omitempty"`
+	InterpolatedEnvVars        map[string]string   `json:"interpolated_env_vars,omitempty"`
+	InterpolatedInlineContents string              `json:"interpolated_inline_contents,omitempty"`
+	RunID                      string              `json:"run_id,omitempty"`
+}
+
+// PlantypesBuildPlan is plantypes.BuildPlan.
+type PlantypesBuildPlan struct {
+	ComponentBuildID            string                                `json:"component_build_id,omitempty"`
+	ComponentID                 string                                `json:"component_id,omitempty"`
+	ContainerImagePullPlan      *PlantypesContainerImagePullPlan      `json:"container_image_pull_plan,omitempty"`
+	DockerBuildPlan             *PlantypesDockerBuildPlan             `json:"docker_build_plan,omitempty"`
+	DstRegistry                 *ConfigsOCIRegistryRepository         `json:"dst_registry"`
+	DstTag                      string                                `json:"dst_tag"`
+	GitSource                   *PlantypesGitSource                   `json:"git_source,omitempty"`
+	HelmBuildPlan               *PlantypesHelmBuildPlan               `json:"helm_build_plan,omitempty"`
+	KubernetesManifestBuildPlan *PlantypesKubernetesManifestBuildPlan `json:"kubernetes_manifest_build_plan,omitempty"`
+	SandboxMode                 *PlantypesSandboxMode                 `json:"sandbox_mode,omitempty"`
+	TerraformBuildPlan          *PlantypesTerraformBuildPlan          `json:"terraform_build_plan,omitempty"`
+}
+
+// PlantypesCompositePlan is plantypes.CompositePlan.
+type PlantypesCompositePlan struct {
+	ActionWorkflowRunPlan  *PlantypesActionWorkflowRunPlan  `json:"action_workflow_run_plan,omitempty"`
+	BuildPlan              *PlantypesBuildPlan              `json:"build_plan,omitempty"`
+	DeployPlan             *PlantypesDeployPlan             `json:"deploy_plan,omitempty"`
+	FetchImageMetadataPlan *PlantypesFetchImageMetadataPlan `json:"fetch_image_metadata_plan,omitempty"`
+	// Auth for cloud providers
+	PlanAuth        *PlantypesPlanAuth        `json:"plan_auth,omitempty"`
+	SandboxRunPlan  *PlantypesSandboxRunPlan  `json:"sandbox_run_plan,omitempty"`
+	SyncOCIPlan     *PlantypesSyncOCIPlan     `json:"sync_oci_plan,omitempty"`
+	SyncSecretsPlan *PlantypesSyncSecretsPlan `json:"sync_secrets_plan,omitempty"`
+}
+
+// PlantypesContainerImagePullPlan is plantypes.ContainerImagePullPlan.
+type PlantypesContainerImagePullPlan struct {
+	Image      string                        `json:"image,omitempty"`
+	RepoConfig *ConfigsOCIRegistryRepository `json:"repo_config,omitempty"`
+	Tag        string                        `json:"tag,omitempty"`
+}
+
+// PlantypesDeployPlan is plantypes.DeployPlan.
+type PlantypesDeployPlan struct {
+	AppConfigID string `json:"app_config_id,omitempty"`
+	AppID       string `json:"app_id,omitempty"`
+	// The following field is for applying a plan that is already save
+	ApplyPlanContents string `json:"apply_plan_contents,omitempty"`
+	// This field is for storing a human legible plan or corollary representation
+	ApplyPlanDisplay   string                                 `json:"apply_plan_display,omitempty"`
+	ComponentID        string                                 `json:"component_id,omitempty"`
+	ComponentName      string                                 `json:"component_name,omitempty"`
+	Helm               *PlantypesHelmDeployPlan               `json:"helm,omitempty"`
+	InstallID          string                                 `json:"install_id,omitempty"`
+	KubernetesManifest *PlantypesKubernetesManifestDeployPlan `json:"kubernetes_manifest,omitempty"`
+	Noop               PlantypesNoopDeployPlan                `json:"noop,omitempty"`
+	SandboxMode        *PlantypesSandboxMode                  `json:"sandbox_mode,omitempty"`
+	SrcRegistry        *ConfigsOCIRegistryRepository          `json:"src_registry"`
+	SrcTag             string                                 `json:"src_tag"`
+	Terraform          *PlantypesTerraformDeployPlan          `json:"terraform,omitempty"`
+}
+
+// PlantypesDockerBuildPlan is plantypes.DockerBuildPlan.
+type PlantypesDockerBuildPlan struct {
+	BuildArgs  map[string]string `json:"build_args,omitempty"`
+	Context    string            `json:"context,omitempty"`
+	Dockerfile string            `json:"dockerfile,omitempty"`
+	Target     string            `json:"target,omitempty"`
+}
+
+// PlantypesFetchImageMetadataPlan is plantypes.FetchImageMetadataPlan.
+type PlantypesFetchImageMetadataPlan struct {
+	IncludeAttestationLayers    bool `json:"include_attestation_layers,omitempty"`
+	IncludeAttestationManifests bool `json:"include_attestation_manifests,omitempty"`
+	// Options for metadata fetching
+	IncludeIndex bool `json:"include_index,omitempty"`
+	// Registry configuration for the source image
+	Registry    *ConfigsOCIRegistryRepository `json:"registry"`
+	SandboxMode *PlantypesSandboxMode         `json:"sandbox_mode,omitempty"`
+	// Tag is the image tag to fetch metadata for
+	Tag string `json:"tag"`
+}
+
+// PlantypesGitSource is plantypes.GitSource.
+type PlantypesGitSource struct {
+	Path              string `json:"path"`
+	RecurseSubmodules bool   `json:"recurse_submodules,omitempty"`
+	Ref               string `json:"ref"`
+	URL               string `json:"url"`
+}
+
+// PlantypesHelmBuildPlan is plantypes.HelmBuildPlan.
+type PlantypesHelmBuildPlan struct {
+	HelmRepoConfig *ConfigHelmRepoConfig `json:"helmRepoConfig,omitempty"`
+	Labels         map[string]string     `json:"labels,omitempty"`
+	Values         []PlantypesHelmValue  `json:"values,omitempty"`
+	ValuesFiles    []string              `json:"valuesFiles,omitempty"`
+}
+
+// PlantypesHelmDeployPlan is plantypes.HelmDeployPlan.
+type PlantypesHelmDeployPlan struct {
+	ClusterInfo     *KubeClusterInfo `json:"cluster_info,omitempty"`
+	CreateNamespace bool             `json:"create_namespace,omitempty"`
+	HelmChartID     string           `json:"helm_chart_id,omitempty"`
+	// NOTE(jm): these fields should probably just come from the app config, however we keep them around for
+	// debuggability
+	Name          string               `json:"name,omitempty"`
+	Namespace     string               `json:"namespace,omitempty"`
+	StorageDriver string               `json:"storage_driver,omitempty"`
+	TakeOwnership bool                 `json:"take_ownership,omitempty"`
+	Values        []PlantypesHelmValue `json:"values,omitempty"`
+	ValuesFiles   []string             `json:"values_files,omitempty"`
+}
+
+// PlantypesHelmSandboxMode is plantypes.HelmSandboxMode.
+type PlantypesHelmSandboxMode struct {
+	PlanContents        string `json:"plan_contents,omitempty"`
+	PlanDisplayContents string `json:"plan_display_contents,omitempty"`
+}
+
+// PlantypesHelmValue is plantypes.HelmValue.
+type PlantypesHelmValue struct {
+	Name  string `json:"name,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// PlantypesKubernetesManifestBuildPlan is plantypes.KubernetesManifestBuildPlan.
+type PlantypesKubernetesManifestBuildPlan struct {
+	// InlineManifest contains the raw manifest YAML (for inline source type)
+	InlineManifest string `json:"inline_manifest,omitempty"`
+	// KustomizeConfig contains additional kustomize build options
+	KustomizeConfig *PlantypesKustomizeBuildConfig `json:"kustomize_config,omitempty"`
+	// KustomizePath is the path to the kustomization directory (for kustomize source type)
+	// Relative to the repository root
+	KustomizePath string `json:"kustomize_path,omitempty"`
+	// Labels for the OCI artifact
+	Labels map[string]string `json:"labels,omitempty"`
+	// SourceType indicates how manifests are sourced: "inline" or "kustomize"
+	SourceType string `json:"source_type,omitempty"`
+}
+
+// PlantypesKubernetesManifestDeployPlan is plantypes.KubernetesManifestDeployPlan.
+type PlantypesKubernetesManifestDeployPlan struct {
+	ClusterInfo *KubeClusterInfo `json:"cluster_info,omitempty"`
+	// Manifest is populated at runtime from the OCI artifact.
+	// This field is no longer set during plan creation - it's populated by the runner
+	// after pulling the OCI artifact during Initialize().
+	Manifest  string `json:"manifest,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	// OCIArtifact reference (set during plan creation, used by runner to pull manifest)
+	OCIArtifact *PlantypesOCIArtifactReference `json:"oci_artifact,omitempty"`
+}
+
+// PlantypesKubernetesSandboxMode is plantypes.KubernetesSandboxMode.
+type PlantypesKubernetesSandboxMode struct {
+	PlanContents        string `json:"plan_contents,omitempty"`
+	PlanDisplayContents string `json:"plan_display_contents,omitempty"`
+}
+
+// PlantypesKubernetesSecretSync is plantypes.KubernetesSecretSync.
+type PlantypesKubernetesSecretSync struct {
+	// NOTE(jm): this should probably come from the app config, but for now we just use string parsing to avoid
+	// updating the runner job and save time.
+	Format    string `json:"format,omitempty"`
+	KeyName   string `json:"key_name,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	SecretARN string `json:"secret_arn,omitempty"`
+	// the name of the secret from the config
+	SecretName string `json:"secret_name,omitempty"`
+}
+
+// PlantypesKustomizeBuildConfig is plantypes.KustomizeBuildConfig.
+type PlantypesKustomizeBuildConfig struct {
+	// EnableHelm enables Helm chart inflation during kustomize build
+	EnableHelm bool `json:"enable_helm,omitempty"`
+	// LoadRestrictor controls file loading: "none" or "rootOnly" (default)
+	LoadRestrictor string `json:"load_restrictor,omitempty"`
+	// Patches are additional patch files to apply after kustomize build
+	Patches []string `json:"patches,omitempty"`
+}
+
+// PlantypesNoopDeployPlan is plantypes.NoopDeployPlan.
+type PlantypesNoopDeployPlan map[string]any
+
+// PlantypesOCIArtifactReference is plantypes.OCIArtifactReference.
+type PlantypesOCIArtifactReference struct {
+	// Digest is the immutable artifact digest (e.g., sha256:abc123...)
+	Digest string `json:"digest,omitempty"`
+	// Tag is the artifact tag (typically the build ID)
+	Tag string `json:"tag,omitempty"`
+	// URL is the full artifact URL (e.g., registry.nuon.co/org_id/app_id)
+	URL string `json:"url,omitempty"`
+}
+
+// PlantypesPlanAuth is plantypes.PlanAuth.
+type PlantypesPlanAuth struct {
+	AWSAuth   *AWSCredentialsConfig   `json:"aws_auth,omitempty"`
+	AzureAuth *AzureCredentialsConfig `json:"azure_auth,omitempty"`
+}
+
+// PlantypesSandboxMode is plantypes.SandboxMode.
+type PlantypesSandboxMode struct {
+	Enabled            bool                            `json:"enabled,omitempty"`
+	Helm               *PlantypesHelmSandboxMode       `json:"helm,omitempty"`
+	KubernetesManifest *PlantypesKubernetesSandboxMode `json:"kubernetes_manifest,omitempty"`
+	Outputs            map[string]any                  `json:"outputs,omitempty"`
+	Terraform          *PlantypesTerraformSandboxMode  `json:"terraform,omitempty"`
+}
+
+// PlantypesSandboxRunPlan is plantypes.SandboxRunPlan.
+type PlantypesSandboxRunPlan struct {
+	AppConfigID string `json:"app_config_id,omitempty"`
+	AppID       string `json:"app_id,omitempty"`
+	// The following field is for applying a plan that is already saved
+	ApplyPlanContents string `json:"apply_plan_contents,omitempty"`
+	// This field is for storing a human legible plan or corollary representation
+	ApplyPlanDisplay []int64                         `json:"apply_plan_display,omitempty"`
+	AWSAuth          *AWSCredentialsConfig           `json:"aws_auth,omitempty"`
+	AzureAuth        *AzureCredentialsConfig         `json:"azure_auth,omitempty"`
+	EnvVars          map[string]string               `json:"env_vars,omitempty"`
+	GitSource        *PlantypesGitSource             `json:"git_source,omitempty"`
+	Hooks            *PlantypesTerraformDeployHooks  `json:"hooks,omitempty"`
+	InstallID        string                          `json:"install_id,omitempty"`
+	LocalArchive     *PlantypesTerraformLocalArchive `json:"local_archive,omitempty"`
+	Policies         map[string]string               `json:"policies,omitempty"`
+	SandboxMode      *PlantypesSandboxMode           `json:"sandbox_mode,omitempty"`
+	State            *TypesStateState                `json:"state,omitempty"`
+	TerraformBackend *PlantypesTerraformBackend      `json:"terraform_backend,omitempty"`
+	Vars             map[string]any                  `json:"vars,omitempty"`
+	VarsFiles        []string                        `json:"vars_files,omitempty"`
+}
+
+// PlantypesSyncOCIPlan is plantypes.SyncOCIPlan.
+type PlantypesSyncOCIPlan struct {
+	DstRegistry *ConfigsOCIRegistryRepository `json:"dst_registry"`
+	DstTag      string                        `json:"dst_tag"`
+	SandboxMode *PlantypesSandboxMode         `json:"sandbox_mode,omitempty"`
+	SrcRegistry *ConfigsOCIRegistryRepository `json:"src_registry"`
+	SrcTag      string                        `json:"src_tag"`
+}
+
+// PlantypesSyncSecretsPlan is plantypes.SyncSecretsPlan.
+type PlantypesSyncSecretsPlan struct {
+	AWSAuth           *AWSCredentialsConfig           `json:"aws_auth,omitempty"`
+	AzureAuth         *AzureCredentialsConfig         `json:"azure_auth,omitempty"`
+	ClusterInfo       *KubeClusterInfo                `json:"cluster_info,omitempty"`
+	KubernetesSecrets []PlantypesKubernetesSecretSync `json:"kubernetes_secrets,omitempty"`
+	SandboxMode       *PlantypesSandboxMode           `json:"sandbox_mode,omitempty"`
+}
+
+// PlantypesTerraformBackend is plantypes.TerraformBackend.
+type PlantypesTerraformBackend struct {
+	WorkspaceID string `json:"workspaceID"`
+}
+
+// PlantypesTerraformBuildPlan is plantypes.TerraformBuildPlan.
+type PlantypesTerraformBuildPlan struct {
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// PlantypesTerraformDeployHooks is plantypes.TerraformDeployHooks.
+type PlantypesTerraformDeployHooks struct {
+	Enabled bool                  `json:"enabled,omitempty"`
+	EnvVars map[string]string     `json:"envVars,omitempty"`
+	RunAuth *AWSCredentialsConfig `json:"runAuth,omitempty"`
+}
+
+// PlantypesTerraformDeployPlan is plantypes.TerraformDeployPlan.
+type PlantypesTerraformDeployPlan struct {
+	AWSAuth          *AWSCredentialsConfig          `json:"aws_auth,omitempty"`
+	AzureAuth        *AzureCredentialsConfig        `json:"azure_auth,omitempty"`
+	ClusterInfo      *KubeClusterInfo               `json:"cluster_info,omitempty"`
+	EnvVars          map[string]string              `json:"env_vars,omitempty"`
+	Hooks            *PlantypesTerraformDeployHooks `json:"hooks,omitempty"`
+	PlanJSON         []int64                        `json:"plan_json,omitempty"`
+	Policies         map[string]string              `json:"policies,omitempty"`
+	State            *TypesStateState               `json:"state,omitempty"`
+	TerraformBackend *PlantypesTerraformBackend     `json:"terraform_backend,omitempty"`
+	Vars             map[string]any                 `json:"vars,omitempty"`
+	VarsFiles        []string                       `json:"vars_files,omitempty"`
+}
+
+// PlantypesTerraformLocalArchive is plantypes.TerraformLocalArchive.
+type PlantypesTerraformLocalArchive struct {
+	LocalArchive string `json:"local_archive,omitempty"`
+}
+
+// PlantypesTerraformSandboxMode is plantypes.TerraformSandboxMode.
+type PlantypesTerraformSandboxMode struct {
+	// create the plan output
+	PlanContents        string `json:"plan_contents,omitempty"`
+	PlanDisplayContents string `json:"plan_display_contents,omitempty"`
+	// needs to be the outputs of `terraform show -json`
+	StateJSON   []int64 `json:"state_json,omitempty"`
+	WorkspaceID string  `json:"workspace_id,omitempty"`
+}
+
+// RefsRef is refs.Ref.
+type RefsRef struct {
+	Input string      `json:"input,omitempty"`
+	Name  string      `json:"name,omitempty"`
+	Type  RefsRefType `json:"type,omitempty"`
+	Value string      `json:"value,omitempty"`
+}
+
+// RefsRefType is refs.RefType.
+type RefsRefType string
+
+const (
+	RefsRefTypeSandbox       RefsRefType = "sandbox"
+	RefsRefTypeInstallStack  RefsRefType = "install_stack"
+	RefsRefTypeComponent     RefsRefType = "component"
+	RefsRefTypeInputs        RefsRefType = "inputs"
+	RefsRefTypeInstallInputs RefsRefType = "install_inputs"
+	RefsRefTypeSecrets       RefsRefType = "secrets"
+	RefsRefTypeActions       RefsRefType = "actions"
+)
+
+// ServiceAppAWSIAMPolicyConfig is service.AppAWSIAMPolicyConfig.
+type ServiceAppAWSIAMPolicyConfig struct {
+	Contents          string `json:"contents,omitempty"`
+	ManagedPolicyName string `json:"managed_policy_name,omitempty"`
+	Name              string `json:"name,omitempty"`
+}
+
+// ServiceAppAWSIAMRoleConfig is service.AppAWSIAMRoleConfig.
+type ServiceAppAWSIAMRoleConfig struct {
+	Description         string                         `json:"description"`
+	DisplayName         string                         `json:"display_name"`
+	Name                string                         `json:"name"`
+	PermissionsBoundary string                         `json:"permissions_boundary,omitempty"`
+	Policies            []ServiceAppAWSIAMPolicyConfig `json:"policies,omitempty"`
+}
+
+// ServiceAppConfigTemplate is service.AppConfigTemplate.
+type ServiceAppConfigTemplate struct {
+	Content  string                       `json:"content,omitempty"`
+	Filename string                       `json:"filename,omitempty"`
+	Format   AppAppConfigVersion          `json:"format,omitempty"`
+	Type     ServiceAppConfigTemplateType `json:"type,omitempty"`
+}
+
+// ServiceAppConfigTemplateType is service.AppConfigTemplateType.
+type ServiceAppConfigTemplateType string
+
+const (
+	ServiceAppConfigTemplateTypeAWSEcs            ServiceAppConfigTemplateType = "aws-ecs"
+	ServiceAppConfigTemplateTypeAWSEcsByovpc      ServiceAppConfigTemplateType = "aws-ecs-byovpc"
+	ServiceAppConfigTemplateTypeAWSEks            ServiceAppConfigTemplateType = "aws-eks"
+	ServiceAppConfigTemplateTypeAWSEksByovpc      ServiceAppConfigTemplateType = "aws-eks-byovpc"
+	ServiceAppConfigTemplateTypeAzureAks          ServiceAppConfigTemplateType = "azure-aks"
+	ServiceAppConfigTemplateTypeFlat              ServiceAppConfigTemplateType = "flat"
+	ServiceAppConfigTemplateTypeTopLevel          ServiceAppConfigTemplateType = "top-level"
+	ServiceAppConfigTemplateTypeInstaller         ServiceAppConfigTemplateType = "installer"
+	ServiceAppConfigTemplateTypeRunner            ServiceAppConfigTemplateType = "runner"
+	ServiceAppConfigTemplateTypeSandbox           ServiceAppConfigTemplateType = "sandbox"
+	ServiceAppConfigTemplateTypeInputs            ServiceAppConfigTemplateType = "inputs"
+	ServiceAppConfigTemplateTypeTerraform         ServiceAppConfigTemplateType = "terraform"
+	ServiceAppConfigTemplateTypeTerraformInfra    ServiceAppConfigTemplateType = "terraformInfra"
+	ServiceAppConfigTemplateTypeHelm              ServiceAppConfigTemplateType = "helm"
+	ServiceAppConfigTemplateTypeDockerBuild       ServiceAppConfigTemplateType = "docker-build"
+	ServiceAppConfigTemplateTypeJob               ServiceAppConfigTemplateType = "job"
+	ServiceAppConfigTemplateTypeContainerImage    ServiceAppConfigTemplateType = "container-image"
+	ServiceAppConfigTemplateTypeEcrContainerImage ServiceAppConfigTemplateType = "ecr-container-image"
+)
+
+// ServiceAppGroupRequest is service.AppGroupRequest.
+type ServiceAppGroupRequest struct {
+	Description string `json:"description"`
+	DisplayName string `json:"display_name"`
+	Index       int64  `json:"index"`
+}
+
+// ServiceAppInputRequest is service.AppInputRequest.
+type ServiceAppInputRequest struct {
+	Default     string `json:"default,omitempty"`
+	Description string `json:"description"`
+	DisplayName string `json:"display_name"`
+	Group       string `json:"group"`
+	Index       int64  `json:"index"`
+	// New, optional fields
+	Internal  bool              `json:"internal,omitempty"`
+	Required  bool              `json:"required,omitempty"`
+	Sensitive bool              `json:"sensitive,omitempty"`
+	Source    AppAppInputSource `json:"source,omitempty"`
+	Type      string            `json:"type,omitempty"`
+}
+
+// ServiceAppPolicyConfig is service.AppPolicyConfig.
+type ServiceAppPolicyConfig struct {
+	Components  []string              `json:"components,omitempty"`
+	Contents    string                `json:"contents"`
+	Description string                `json:"description,omitempty"`
+	Engine      ConfigAppPolicyEngine `json:"engine,omitempty"`
+	Name        string                `json:"name,omitempty"`
+	Type        ConfigAppPolicyType   `json:"type"`
+}
+
+// ServiceAppSecretConfig is service.AppSecretConfig.
+type ServiceAppSecretConfig struct {
+	AutoGenerate              bool   `json:"auto_generate,omitempty"`
+	Default                   string `json:"default,omitempty"`
+	Description               string `json:"description"`
+	DisplayName               string `json:"display_name"`
+	Format                    string `json:"format,omitempty"`
+	KubernetesSecretName      string `json:"kubernetes_secret_name,omitempty"`
+	KubernetesSecretNamespace string `json:"kubernetes_secret_namespace,omitempty"`
+	KubernetesSync            bool   `json:"kubernetes_sync,omitempty"`
+	Name                      string `json:"name"`
+	Required                  bool   `json:"required,omitempty"`
+}
+
+// ServiceAuthMeIdentity is service.AuthMeIdentity.
+type ServiceAuthMeIdentity struct {
+	Name         string          `json:"name,omitempty"`
+	Picture      string          `json:"picture,omitempty"`
+	ProviderType AppProviderType `json:"provider_type,omitempty"`
+}
+
+// ServiceAuthMeResponse is service.AuthMeResponse.
+type ServiceAuthMeResponse struct {
+	AccountType AppAccountType          `json:"account_type,omitempty"`
+	CreatedAt   string                  `json:"created_at,omitempty"`
+	Email       string                  `json:"email,omitempty"`
+	ID          string                  `json:"id,omitempty"`
+	Identities  []ServiceAuthMeIdentity `json:"identities,omitempty"`
+	// ReadOnly Fields
+	OrgIDs       []string         `json:"org_ids,omitempty"`
+	Permissions  PermissionsSet   `json:"permissions,omitempty"`
+	Roles        []AppRole        `json:"roles,omitempty"`
+	Subject      string           `json:"subject,omitempty"`
+	UpdatedAt    string           `json:"updated_at,omitempty"`
+	UserJourneys []AppUserJourney `json:"user_journeys,omitempty"`
+}
+
+// ServiceAvailableRole is service.AvailableRole.
+type ServiceAvailableRole struct {
+	ARN      string `json:"arn,omitempty"`
+	Name     string `json:"name,omitempty"`
+	RoleType string `json:"role_type,omitempty"`
+}
+
+// ServiceAvailableRolesResponse is service.AvailableRolesResponse.
+type ServiceAvailableRolesResponse struct {
+	Roles []ServiceAvailableRole `json:"roles,omitempty"`
+}
+
+// ServiceAwsECRImageConfigRequest is service.awsECRImageConfigRequest.
+type ServiceAwsECRImageConfigRequest struct {
+	AWSRegion  string `json:"aws_region,omitempty"`
+	IAMRoleARN string `json:"iam_role_arn,omitempty"`
+}
+
+// ServiceBuildAllComponentsRequest is service.BuildAllComponentsRequest.
+type ServiceBuildAllComponentsRequest map[string]any
+
+// ServiceCLIConfig is service.CLIConfig.
+type ServiceCLIConfig struct {
+	AuthAudience    string `json:"auth_audience,omitempty"`
+	AuthClientID    string `json:"auth_client_id,omitempty"`
+	AuthDomain      string `json:"auth_domain,omitempty"`
+	DashboardURL    string `json:"dashboard_url,omitempty"`
+	NuonAuthEnabled bool   `json:"nuon_auth_enabled,omitempty"`
+	RootDomain      string `json:"root_domain,omitempty"`
+}
+
+// ServiceCancelRunnerJobRequest is service.CancelRunnerJobRequest.
+type ServiceCancelRunnerJobRequest map[string]any
+
+// ServiceComponentChildren is service.ComponentChildren.
+type ServiceComponentChildren struct {
+	Children []AppComponent `json:"children,omitempty"`
+}
+
+// ServiceConnectedGithubVCSActionWorkflowConfigRequest is service.ConnectedGithubVCSActionWorkflowConfigRequest.
+type ServiceConnectedGithubVCSActionWorkflowConfigRequest struct {
+	Branch    string `json:"branch,omitempty"`
+	Directory string `json:"directory"`
+	GitRef    string `json:"gitRef,omitempty"`
+	Repo      string `json:"repo"`
+}
+
+// ServiceConnectedGithubVCSConfigRequest is service.ConnectedGithubVCSConfigRequest.
+type ServiceConnectedGithubVCSConfigRequest struct {
+	Branch    string `json:"branch,omitempty"`
+	Directory string `json:"directory"`
+	GitRef    string `json:"gitRef,omitempty"`
+	Repo      string `json:"repo"`
+}
+
+// ServiceConnectedGithubVCSSandboxConfigRequest is service.ConnectedGithubVCSSandboxConfigRequest.
+type ServiceConnectedGithubVCSSandboxConfigRequest struct {
+	Branch    string `json:"branch,omitempty"`
+	Directory string `json:"directory"`
+	GitRef    string `json:"gitRef,omitempty"`
+	Repo      string `json:"repo"`
+}
+
+// ServiceCreateActionWorkflowConfigRequest is service.CreateActionWorkflowConfigRequest.
+type ServiceCreateActionWorkflowConfigRequest struct {
+	AppConfigID       string                                            `json:"app_config_id"`
+	BreakGlassRoleARN string                                            `json:"break_glass_role_arn,omitempty"`
+	Dependencies      []string                                          `json:"dependencies,omitempty"`
+	References        []string                                          `json:"references,omitempty"`
+	Role              string                                            `json:"role,omitempty"`
+	Steps             []ServiceCreateActionWorkflowConfigStepRequest    `json:"steps"`
+	Timeout           int64                                             `json:"timeout,omitempty"`
+	Triggers          []ServiceCreateActionWorkflowConfigTriggerRequest `json:"triggers"`
+}
+
+// ServiceCreateActionWorkflowConfigStepRequest is service.CreateActionWorkflowConfigStepRequest.
+type ServiceCreateActionWorkflowConfigStepRequest struct {
+	Command                  string                                                `json:"command,omitempty"`
+	ConnectedGithubVCSConfig *ServiceConnectedGithubVCSActionWorkflowConfigRequest `json:"connected_github_vcs_config,omitempty"`
+	EnvVars                  map[string]string                                     `json:"env_vars,omitempty"`
+	InlineContents           string                                                `json:"inline_contents,omitempty"`
+	Name                     string                                                `json:"name"`
+	PublicGitVCSConfig       *ServicePublicGitVCSActionWorkflowConfigRequest       `json:"public_git_vcs_config,omitempty"`
+	References               []string                                              `json:"references,omitempty"`
+}
+
+// ServiceCreateActionWorkflowConfigTriggerRequest is service.CreateActionWorkflowConfigTriggerRequest.
+type ServiceCreateActionWorkflowConfigTriggerRequest struct {
+	ComponentName string                       `json:"component_name,omitempty"`
+	CronSchedule  string                       `json:"cron_schedule,omitempty"`
+	Index         int64                        `json:"index,omitempty"`
+	Type          AppActionWorkflowTriggerType `json:"type"`
+}
+
+// ServiceCreateAdHocActionRequest is service.CreateAdHocActionRequest.
+type ServiceCreateAdHocActionRequest struct {
+	Command        string            `json:"command,omitempty"`
+	EnvVars        map[string]string `json:"env_vars,omitempty"`
+	InlineContents string            `json:"inline_contents,omitempty"`
+	Name           string            `json:"name,omitempty"`
+	Role           string            `json:"role,omitempty"`
+	Timeout        int64             `json:"timeout,omitempty"`
+}
+
+// ServiceCreateAdHocActionResponse is service.CreateAdHocActionResponse.
+type ServiceCreateAdHocActionResponse struct {
+	CreatedAt         string                            `json:"created_at,omitempty"`
+	ID                string                            `json:"id,omitempty"`
+	InstallID         string                            `json:"install_id,omitempty"`
+	Status            AppInstallActionWorkflowRunStatus `json:"status,omitempty"`
+	StatusDescription string                            `json:"status_description,omitempty"`
+	TriggerType       AppActionWorkflowTriggerType      `json:"trigger_type,omitempty"`
+	WorkflowID        string                            `json:"workflow_id,omitempty"`
+}
+
+// ServiceCreateAppActionRequest is service.CreateAppActionRequest.
+type ServiceCreateAppActionRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+// ServiceCreateAppActionWorkflowRequest is service.CreateAppActionWorkflowRequest.
+type ServiceCreateAppActionWorkflowRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+// ServiceCreateAppBranchRequest is service.CreateAppBranchRequest.
+type ServiceCreateAppBranchRequest struct {
+	ConnectedGithubVCSConfigID string `json:"connected_github_vcs_config_id"`
+	Name                       string `json:"name"`
+}
+
+// ServiceCreateAppBreakGlassConfigRequest is service.CreateAppBreakGlassConfigRequest.
+type ServiceCreateAppBreakGlassConfigRequest struct {
+	AppConfigID string                       `json:"app_config_id"`
+	Roles       []ServiceAppAWSIAMRoleConfig `json:"roles"`
+}
+
+// ServiceCreateAppConfigRequest is service.CreateAppConfigRequest.
+type ServiceCreateAppConfigRequest struct {
+	CliVersion string `json:"cli_version,omitempty"`
+	// not required Readme
+	Readme string `json:"readme,omitempty"`
+}
+
+// ServiceCreateAppInputConfigRequest is service.CreateAppInputConfigRequest.
+type ServiceCreateAppInputConfigRequest struct {
+	AppConfigID string                            `json:"app_config_id,omitempty"`
+	Groups      map[string]ServiceAppGroupRequest `json:"groups"`
+	Inputs      map[string]ServiceAppInputRequest `json:"inputs"`
+}
+
+// ServiceCreateAppOperationRoleConfigRequest is service.CreateAppOperationRoleConfigRequest.
+type ServiceCreateAppOperationRoleConfigRequest struct {
+	AppConfigID string                            `json:"app_config_id"`
+	Rules       []ServiceOperationRoleRuleRequest `json:"rules"`
+}
+
+// ServiceCreateAppPermissionsConfigRequest is service.CreateAppPermissionsConfigRequest.
+type ServiceCreateAppPermissionsConfigRequest struct {
+	AppConfigID     string                       `json:"app_config_id"`
+	BreakGlassRoles []ServiceAppAWSIAMRoleConfig `json:"break_glass_roles,omitempty"`
+	CustomRoles     []ServiceAppAWSIAMRoleConfig `json:"custom_roles,omitempty"`
+	DeprovisionRole *ServiceAppAWSIAMRoleConfig  `json:"deprovision_role"`
+	MaintenanceRole *ServiceAppAWSIAMRoleConfig  `json:"maintenance_role"`
+	ProvisionRole   *ServiceAppAWSIAMRoleConfig  `json:"provision_role"`
+}
+
+// ServiceCreateAppPoliciesConfigRequest is service.CreateAppPoliciesConfigRequest.
+type ServiceCreateAppPoliciesConfigRequest struct {
+	AppConfigID string                   `json:"app_config_id"`
+	Policies    []ServiceAppPolicyConfig `json:"policies,omitempty"`
+}
+
+// ServiceCreateAppRequest is service.CreateAppRequest.
+type ServiceCreateAppRequest struct {
+	Description     string `json:"description,omitempty"`
+	DisplayName     string `json:"display_name,omitempty"`
+	Name            string `json:"name"`
+	SlackWebhookURL string `json:"slack_webhook_url,omitempty"`
+}
+
+// ServiceCreateAppRunnerConfigRequest is service.CreateAppRunnerConfigRequest.
+type ServiceCreateAppRunnerConfigRequest struct {
+	AppConfigID   string                           `json:"app_config_id,omitempty"`
+	EnvVars       map[string]string                `json:"env_vars,omitempty"`
+	HelmDriver    AppAppRunnerConfigHelmDriverType `json:"helm_driver,omitempty"`
+	InitScriptURL string                           `json:"init_script_url,omitempty"`
+	Type          AppAppRunnerType                 `json:"type"`
+}
+
+// ServiceCreateAppSandboxConfigRequest is service.CreateAppSandboxConfigRequest.
+type ServiceCreateAppSandboxConfigRequest struct {
+	AppConfigID              string                                         `json:"app_config_id,omitempty"`
+	ConnectedGithubVCSConfig *ServiceConnectedGithubVCSSandboxConfigRequest `json:"connected_github_vcs_config,omitempty"`
+	DriftSchedule            string                                         `json:"drift_schedule,omitempty"`
+	EnvVars                  map[string]string                              `json:"env_vars"`
+	OperationRoles           map[string]string                              `json:"operation_roles,omitempty"`
+	PublicGitVCSConfig       *ServicePublicGitVCSSandboxConfigRequest       `json:"public_git_vcs_config,omitempty"`
+	References               []string                                       `json:"references,omitempty"`
+	TerraformVersion         string                                         `json:"terraform_version"`
+	Variables                map[string]string                              `json:"variables"`
+	VariablesFiles           []string                                       `json:"variables_files,omitempty"`
+}
+
+// ServiceCreateAppSecretRequest is service.CreateAppSecretRequest.
+type ServiceCreateAppSecretRequest struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ServiceCreateAppSecretsConfigRequest is service.CreateAppSecretsConfigRequest.
+type ServiceCreateAppSecretsConfigRequest struct {
+	AppConfigID string                   `json:"app_config_id"`
+	Secrets     []ServiceAppSecretConfig `json:"secrets,omitempty"`
+}
+
+// ServiceCreateAppStackConfigRequest is service.CreateAppStackConfigRequest.
+type ServiceCreateAppStackConfigRequest struct {
+	AppConfigID             string                    `json:"app_config_id"`
+	CustomNestedStacks      []ConfigCustomNestedStack `json:"custom_nested_stacks,omitempty"`
+	Description             string                    `json:"description"`
+	Name                    string                    `json:"name"`
+	RunnerNestedTemplateURL string                    `json:"runner_nested_template_url,omitempty"`
+	Type                    AppStackType              `json:"type"`
+	VPCNestedTemplateURL    string                    `json:"vpc_nested_template_url,omitempty"`
+}
+
+// ServiceCreateComponentBuildRequest is service.CreateComponentBuildRequest.
+type ServiceCreateComponentBuildRequest struct {
+	GitRef    string `json:"git_ref,omitempty"`
+	UseLatest bool   `json:"use_latest,omitempty"`
+}
+
+// ServiceCreateComponentReleaseRequest is service.CreateComponentReleaseRequest.
+type ServiceCreateComponentReleaseRequest struct {
+	AutoBuild  bool           `json:"auto_build,omitempty"`
+	BuildID    string         `json:"build_id,omitempty"`
+	InstallIDs []string       `json:"install_ids,omitempty"`
+	Strategy   map[string]any `json:"strategy,omitempty"`
+}
+
+// ServiceCreateComponentRequest is service.CreateComponentRequest.
+type ServiceCreateComponentRequest struct {
+	Dependencies []string `json:"dependencies,omitempty"`
+	Name         string   `json:"name"`
+	VarName      string   `json:"var_name,omitempty"`
+}
+
+// ServiceCreateConnectionCallbackRequest is service.CreateConnectionCallbackRequest.
+type ServiceCreateConnectionCallbackRequest struct {
+	GithubInstallID string `json:"github_install_id"`
+	OrgID           string `json:"org_id"`
+}
+
+// ServiceCreateConnectionRequest is service.CreateConnectionRequest.
+type ServiceCreateConnectionRequest struct {
+	GithubInstallID string `json:"github_install_id"`
+}
+
+// ServiceCreateDockerBuildComponentConfigRequest is service.CreateDockerBuildComponentConfigRequest.
+type ServiceCreateDockerBuildComponentConfigRequest struct {
+	AppConfigID string   `json:"app_config_id,omitempty"`
+	BuildArgs   []string `json:"build_args,omitempty"`
+	// Duration string for build operations (e.g., "30m", "1h")
+	BuildTimeout             string                                  `json:"build_timeout,omitempty"`
+	Checksum                 string                                  `json:"checksum,omitempty"`
+	ConnectedGithubVCSConfig *ServiceConnectedGithubVCSConfigRequest `json:"connected_github_vcs_config,omitempty"`
+	Dependencies             []string                                `json:"dependencies,omitempty"`
+	// Duration string for deploy operations (e.g., "30m", "1h")
+	DeployTimeout      string                            `json:"deploy_timeout,omitempty"`
+	Dockerfile         string                            `json:"dockerfile"`
+	EnvVars            map[string]string                 `json:"env_vars,omitempty"`
+	OperationRoles     map[string]string                 `json:"operation_roles,omitempty"`
+	PublicGitVCSConfig *ServicePublicGitVCSConfigRequest `json:"public_git_vcs_config,omitempty"`
+	References         []string                          `json:"references,omitempty"`
+	Target             string                            `json:"target,omitempty"`
+}
+
+// ServiceCreateExternalImageComponentConfigRequest is service.CreateExternalImageComponentConfigRequest.
+type ServiceCreateExternalImageComponentConfigRequest struct {
+	AppConfigID       string                           `json:"app_config_id,omitempty"`
+	AWSEcrImageConfig *ServiceAwsECRImageConfigRequest `json:"aws_ecr_image_config,omitempty"`
+	// Duration string for build operations (e.g., "30m", "1h")
+	BuildTimeout string   `json:"build_timeout,omitempty"`
+	Checksum     string   `json:"checksum,omitempty"`
+	Dependencies []string `json:"dependencies,omitempty"`
+	// Duration string for deploy operations (e.g., "30m", "1h")
+	DeployTimeout  string            `json:"deploy_timeout,omitempty"`
+	ImageURL       string            `json:"image_url"`
+	OperationRoles map[string]string `json:"operation_roles,omitempty"`
+	References     []string          `json:"references,omitempty"`
+	Tag            string            `json:"tag"`
+}
+
+// ServiceCreateHelmComponentConfigRequest is service.CreateHelmComponentConfigRequest.
+type ServiceCreateHelmComponentConfigRequest struct {
+	AppConfigID string `json:"app_config_id,omitempty"`
+	// Duration string for build operations (e.g., "30m", "1h")
+	BuildTimeout             string                                  `json:"build_timeout,omitempty"`
+	ChartName                string                                  `json:"chart_name"`
+	Checksum                 string                                  `json:"checksum,omitempty"`
+	ConnectedGithubVCSConfig *ServiceConnectedGithubVCSConfigRequest `json:"connected_github_vcs_config,omitempty"`
+	Dependencies             []string                                `json:"dependencies,omitempty"`
+	// Duration string for deploy operations (e.g., "30m", "1h")
+	DeployTimeout      string                            `json:"deploy_timeout,omitempty"`
+	DriftSchedule      string                            `json:"drift_schedule,omitempty"`
+	HelmRepoConfig     *ServiceHelmRepoConfigRequest     `json:"helm_repo_config,omitempty"`
+	Namespace          string                            `json:"namespace,omitempty"`
+	OperationRoles     map[string]string                 `json:"operation_roles,omitempty"`
+	PublicGitVCSConfig *ServicePublicGitVCSConfigRequest `json:"public_git_vcs_config,omitempty"`
+	References         []string                          `json:"references,omitempty"`
+	StorageDriver      string                            `json:"storage_driver,omitempty"`
+	TakeOwnership      bool                              `json:"take_ownership,omitempty"`
+	Values             map[string]string                 `json:"values"`
+	ValuesFiles        []string                          `json:"values_files,omitempty"`
+}
+
+// ServiceCreateInstallActionWorkflowRunRequest is service.CreateInstallActionWorkflowRunRequest.
+type ServiceCreateInstallActionWorkflowRunRequest struct {
+	ActionWorkflowConfigID string            `json:"action_workflow_config_id"`
+	Role                   string            `json:"role,omitempty"`
+	RunEnvVars             map[string]string `json:"run_env_vars,omitempty"`
+}
+
+// ServiceCreateInstallComponentDeployRequest is service.CreateInstallComponentDeployRequest.
+type ServiceCreateInstallComponentDeployRequest struct {
+	BuildID          string `json:"build_id,omitempty"`
+	DeployDependents bool   `json:"deploy_dependents,omitempty"`
+	PlanOnly         bool   `json:"plan_only,omitempty"`
+	Role             string `json:"role,omitempty"`
+}
+
+// ServiceCreateInstallConfigRequest is service.CreateInstallConfigRequest.
+type ServiceCreateInstallConfigRequest struct {
+	ApprovalOption AppInstallApprovalOption `json:"approval_option,omitempty"`
+}
+
+// ServiceCreateInstallDeployRequest is service.CreateInstallDeployRequest.
+type ServiceCreateInstallDeployRequest struct {
+	BuildID          string `json:"build_id,omitempty"`
+	DeployDependents bool   `json:"deploy_dependents,omitempty"`
+	PlanOnly         bool   `json:"plan_only,omitempty"`
+	Role             string `json:"role,omitempty"`
+}
+
+// ServiceCreateInstallInputsRequest is service.CreateInstallInputsRequest.
+type ServiceCreateInstallInputsRequest struct {
+	Inputs map[string]string `json:"inputs"`
+}
+
+// ServiceCreateInstallRequest is service.CreateInstallRequest.
+type ServiceCreateInstallRequest struct {
+	AWSAccount    map[string]any                    `json:"aws_account,omitempty"`
+	AzureAccount  map[string]any                    `json:"azure_account,omitempty"`
+	Inputs        map[string]string                 `json:"inputs,omitempty"`
+	InstallConfig *HelpersCreateInstallConfigParams `json:"install_config,omitempty"`
+	Metadata      *HelpersInstallMetadata           `json:"metadata,omitempty"`
+	Name          string                            `json:"name"`
+}
+
+// ServiceCreateInstallV2Request is service.CreateInstallV2Request.
+type ServiceCreateInstallV2Request struct {
+	AppID         string                            `json:"app_id"`
+	AWSAccount    map[string]any                    `json:"aws_account,omitempty"`
+	AzureAccount  map[string]any                    `json:"azure_account,omitempty"`
+	Inputs        map[string]string                 `json:"inputs,omitempty"`
+	InstallConfig *HelpersCreateInstallConfigParams `json:"install_config,omitempty"`
+	Metadata      *HelpersInstallMetadata           `json:"metadata,omitempty"`
+	Name          string                            `json:"name"`
+}
+
+// ServiceCreateJobComponentConfigRequest is service.CreateJobComponentConfigRequest.
+type ServiceCreateJobComponentConfigRequest struct {
+	AppConfigID string   `json:"app_config_id,omitempty"`
+	Args        []string `json:"args,omitempty"`
+	// Duration string for build operations (e.g., "30m", "1h")
+	BuildTimeout string   `json:"build_timeout,omitempty"`
+	Checksum     string   `json:"checksum,omitempty"`
+	Cmd          []string `json:"cmd,omitempty"`
+	// Duration string for deploy operations (e.g., "30m", "1h")
+	DeployTimeout  string            `json:"deploy_timeout,omitempty"`
+	EnvVars        map[string]string `json:"env_vars,omitempty"`
+	ImageURL       string            `json:"image_url"`
+	OperationRoles map[string]string `json:"operation_roles,omitempty"`
+	References     []string          `json:"references,omitempty"`
+	Tag            string            `json:"tag"`
+}
+
+// ServiceCreateKubernetesManifestComponentConfigRequest is service.CreateKubernetesManifestComponentConfigRequest.
+type ServiceCreateKubernetesManifestComponentConfigRequest struct {
+	AppConfigID string `json:"app_config_id,omitempty"`
+	// Duration string for build operations (e.g., "30m", "1h")
+	BuildTimeout             string                                  `json:"build_timeout,omitempty"`
+	Checksum                 string                                  `json:"checksum,omitempty"`
+	ConnectedGithubVCSConfig *ServiceConnectedGithubVCSConfigRequest `json:"connected_github_vcs_config,omitempty"`
+	Dependencies             []string                                `json:"dependencies,omitempty"`
+	// Duration string for deploy operations (e.g., "30m", "1h")
+	DeployTimeout string `json:"deploy_timeout,omitempty"`
+	DriftSchedule string `json:"drift_schedule,omitempty"`
+	// Kustomize configuration (mutually exclusive with Manifest)
+	Kustomize *ServiceKustomizeConfigRequest `json:"kustomize,omitempty"`
+	// Inline manifest (mutually exclusive with Kustomize)
+	Manifest           string                            `json:"manifest,omitempty"`
+	Namespace          string                            `json:"namespace,omitempty"`
+	OperationRoles     map[string]string                 `json:"operation_roles,omitempty"`
+	PublicGitVCSConfig *ServicePublicGitVCSConfigRequest `json:"public_git_vcs_config,omitempty"`
+	References         []string                          `json:"references,omitempty"`
+}
+
+// ServiceCreateOrgInviteRequest is service.CreateOrgInviteRequest.
+type ServiceCreateOrgInviteRequest struct {
+	Email string `json:"email"`
+}
+
+// ServiceCreateOrgRequest is service.CreateOrgRequest.
+type ServiceCreateOrgRequest struct {
+	Name string `json:"name"`
+	// These fields are used to control the behaviour of the org.
+	UseSandboxMode bool `json:"use_sandbox_mode,omitempty"`
+}
+
+// ServiceCreateOrgUserRequest is service.CreateOrgUserRequest.
+type ServiceCreateOrgUserRequest struct {
+	UserID string `json:"user_id,omitempty"`
+}
+
+// ServiceCreateTerraformModuleComponentConfigRequest is service.CreateTerraformModuleComponentConfigRequest.
+type ServiceCreateTerraformModuleComponentConfigRequest struct {
+	AppConfigID string `json:"app_config_id,omitempty"`
+	// Duration string for build operations (e.g., "30m", "1h")
+	BuildTimeout             string                                  `json:"build_timeout,omitempty"`
+	Checksum                 string                                  `json:"checksum,omitempty"`
+	ConnectedGithubVCSConfig *ServiceConnectedGithubVCSConfigRequest `json:"connected_github_vcs_config,omitempty"`
+	Dependencies             []string                                `json:"dependencies,omitempty"`
+	// Duration string for deploy operations (e.g., "30m", "1h")
+	DeployTimeout      string                            `json:"deploy_timeout,omitempty"`
+	DriftSchedule      string                            `json:"drift_schedule,omitempty"`
+	EnvVars            map[string]string                 `json:"env_vars"`
+	OperationRoles     map[string]string                 `json:"operation_roles,omitempty"`
+	PublicGitVCSConfig *ServicePublicGitVCSConfigRequest `json:"public_git_vcs_config,omitempty"`
+	References         []string                          `json:"references,omitempty"`
+	Variables          map[string]string                 `json:"variables"`
+	VariablesFiles     []string                          `json:"variables_files,omitempty"`
+	Version            string                            `json:"version,omitempty"`
+}
+
+// ServiceCreateTerraformWorkspaceRequest is service.CreateTerraformWorkspaceRequest.
+type ServiceCreateTerraformWorkspaceRequest struct {
+	OwnerID   string `json:"owner_id"`
+	OwnerType string `json:"owner_type"`
+}
+
+// ServiceCreateUserJourneyRequest is service.CreateUserJourneyRequest.
+type ServiceCreateUserJourneyRequest struct {
+	Name  string                            `json:"name"`
+	Steps []ServiceCreateUserJourneyStepReq `json:"steps"`
+	Title string                            `json:"title"`
+}
+
+// ServiceCreateUserJourneyStepReq is service.CreateUserJourneyStepReq.
+type ServiceCreateUserJourneyStepReq struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+// ServiceCreateWorkflowStepApprovalResponseRequest is service.CreateWorkflowStepApprovalResponseRequest.
+type ServiceCreateWorkflowStepApprovalResponseRequest struct {
+	Note         string                      `json:"note,omitempty"`
+	ResponseType AppWorkflowStepResponseType `json:"response_type,omitempty"`
+}
+
+// ServiceCreateWorkflowStepApprovalResponseResponse is service.CreateWorkflowStepApprovalResponseResponse.
+type ServiceCreateWorkflowStepApprovalResponseResponse struct {
+	ID   string `json:"id,omitempty"`
+	Note string `json:"note,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// ServiceDeployInstallComponentsRequest is service.DeployInstallComponentsRequest.
+type ServiceDeployInstallComponentsRequest struct {
+	PlanOnly bool   `json:"plan_only,omitempty"`
+	Role     string `json:"role,omitempty"`
+}
+
+// ServiceDeprovisionInstallRequest is service.DeprovisionInstallRequest.
+type ServiceDeprovisionInstallRequest struct {
+	PlanOnly bool `json:"plan_only,omitempty"`
+}
+
+// ServiceDeprovisionInstallSandboxRequest is service.DeprovisionInstallSandboxRequest.
+type ServiceDeprovisionInstallSandboxRequest struct {
+	PlanOnly bool   `json:"plan_only,omitempty"`
+	Role     string `json:"role,omitempty"`
+}
+
+// ServiceForceShutdownRequest is service.ForceShutdownRequest.
+type ServiceForceShutdownRequest map[string]any
+
+// ServiceForgetInstallComponentRequest is service.ForgetInstallComponentRequest.
+type ServiceForgetInstallComponentRequest map[string]any
+
+// ServiceForgetInstallRequest is service.ForgetInstallRequest.
+type ServiceForgetInstallRequest map[string]any
+
+// ServiceGracefulShutdownRequest is service.GracefulShutdownRequest.
+type ServiceGracefulShutdownRequest map[string]any
+
+// ServiceHelmRepoConfigRequest is service.HelmRepoConfigRequest.
+type ServiceHelmRepoConfigRequest struct {
+	Chart   string `json:"chart"`
+	RepoURL string `json:"repo_url"`
+	Version string `json:"version,omitempty"`
+}
+
+// ServiceInstallPhoneHomeRequest is service.InstallPhoneHomeRequest.
+type ServiceInstallPhoneHomeRequest map[string]any
+
+// ServiceKustomizeConfigRequest is service.KustomizeConfigRequest.
+type ServiceKustomizeConfigRequest struct {
+	EnableHelm     bool     `json:"enable_helm,omitempty"`
+	LoadRestrictor string   `json:"load_restrictor,omitempty"`
+	Patches        []string `json:"patches,omitempty"`
+	Path           string   `json:"path,omitempty"`
+}
+
+// ServiceLatestRunnerHeartBeats is service.LatestRunnerHeartBeats.
+type ServiceLatestRunnerHeartBeats map[string]AppLatestRunnerHeartBeat
+
+// ServiceMngFetchTokenRequest is service.MngFetchTokenRequest.
+type ServiceMngFetchTokenRequest map[string]any
+
+// ServiceMngShutDownRequest is service.MngShutDownRequest.
+type ServiceMngShutDownRequest map[string]any
+
+// ServiceMngUpdateRequest is service.MngUpdateRequest.
+type ServiceMngUpdateRequest map[string]any
+
+// ServiceMngVMShutDownRequest is service.MngVMShutDownRequest.
+type ServiceMngVMShutDownRequest map[string]any
+
+// ServiceOperationRoleRuleRequest is service.OperationRoleRuleRequest.
+type ServiceOperationRoleRuleRequest struct {
+	Operation AppOperationType `json:"operation"`
+	Principal string           `json:"principal"`
+	Role      string           `json:"role"`
+}
+
+// ServicePatchInstallConfigParams is service.PatchInstallConfigParams.
+type ServicePatchInstallConfigParams struct {
+	ApprovalOption AppInstallApprovalOption `json:"approval_option,omitempty"`
+}
+
+// ServicePruneTokensResponse is service.PruneTokensResponse.
+type ServicePruneTokensResponse struct {
+	InvalidatedCount int64 `json:"invalidated_count,omitempty"`
+}
+
+// ServicePublicGitVCSActionWorkflowConfigRequest is service.PublicGitVCSActionWorkflowConfigRequest.
+type ServicePublicGitVCSActionWorkflowConfigRequest struct {
+	Branch    string `json:"branch"`
+	Directory string `json:"directory"`
+	Repo      string `json:"repo"`
+}
+
+// ServicePublicGitVCSConfigRequest is service.PublicGitVCSConfigRequest.
+type ServicePublicGitVCSConfigRequest struct {
+	Branch    string `json:"branch"`
+	Directory string `json:"directory"`
+	Repo      string `json:"repo"`
+}
+
+// ServicePublicGitVCSSandboxConfigRequest is service.PublicGitVCSSandboxConfigRequest.
+type ServicePublicGitVCSSandboxConfigRequest struct {
+	Branch    string `json:"branch"`
+	Directory string `json:"directory"`
+	Repo      string `json:"repo"`
+}
+
+// ServiceReadme is service.Readme.
+type ServiceReadme struct {
+	Original string   `json:"original,omitempty"`
+	Readme   string   `json:"readme,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ServiceRemoveOrgUserRequest is service.RemoveOrgUserRequest.
+type ServiceRemoveOrgUserRequest struct {
+	UserID string `json:"user_id,omitempty"`
+}
+
+// ServiceReprovisionInstallRequest is service.ReprovisionInstallRequest.
+type ServiceReprovisionInstallRequest struct {
+	PlanOnly bool `json:"plan_only,omitempty"`
+}
+
+// ServiceReprovisionInstallSandboxRequest is service.ReprovisionInstallSandboxRequest.
+type ServiceReprovisionInstallSandboxRequest struct {
+	PlanOnly bool   `json:"plan_only,omitempty"`
+	Role     string `json:"role,omitempty"`
+}
+
+// ServiceRetryWorkflowByIDRequest is service.RetryWorkflowByIDRequest.
+type ServiceRetryWorkflowByIDRequest struct {
+	// Retry indicates whether to retry the current step or not
+	Operation string `json:"operation,omitempty"`
+	// StepID is the ID of the step to start the retry from
+	StepID string `json:"step_id,omitempty"`
+}
+
+// ServiceRetryWorkflowByIDResponse is service.RetryWorkflowByIDResponse.
+type ServiceRetryWorkflowByIDResponse struct {
+	WorkflowID string `json:"workflow_id,omitempty"`
+}
+
+// ServiceRetryWorkflowRequest is service.RetryWorkflowRequest.
+type ServiceRetryWorkflowRequest struct {
+	// Retry indicates whether to retry the current step or not
+	Operation string `json:"operation,omitempty"`
+	// StepID is the ID of the step to start the retry from
+	StepID     string `json:"step_id,omitempty"`
+	WorkflowID string `json:"workflow_id,omitempty"`
+}
+
+// ServiceRetryWorkflowResponse is service.RetryWorkflowResponse.
+type ServiceRetryWorkflowResponse struct {
+	WorkflowID string `json:"workflow_id,omitempty"`
+}
+
+// ServiceRetryWorkflowStepRequest is service.RetryWorkflowStepRequest.
+type ServiceRetryWorkflowStepRequest struct {
+	// Retry indicates whether to retry the current step or not
+	Operation string `json:"operation,omitempty"`
+}
+
+// ServiceRunnerCardDetailsResponse is service.RunnerCardDetailsResponse.
+type ServiceRunnerCardDetailsResponse struct {
+	LatestHeartBeat *AppRunnerHeartBeat `json:"latest_heart_beat,omitempty"`
+	Runner          *AppRunner          `json:"runner,omitempty"`
+}
+
+// ServiceRunnerConnectionStatus is service.RunnerConnectionStatus.
+type ServiceRunnerConnectionStatus struct {
+	Connected                bool  `json:"connected,omitempty"`
+	LatestHeartbeatTimestamp int64 `json:"latest_heartbeat_timestamp,omitempty"`
+}
+
+// ServiceSyncSecretsRequest is service.SyncSecretsRequest.
+type ServiceSyncSecretsRequest struct {
+	PlanOnly bool `json:"plan_only,omitempty"`
+}
+
+// ServiceTeardownInstallComponentRequest is service.TeardownInstallComponentRequest.
+type ServiceTeardownInstallComponentRequest struct {
+	PlanOnly bool   `json:"plan_only,omitempty"`
+	Role     string `json:"role,omitempty"`
+}
+
+// ServiceTeardownInstallComponentsRequest is service.TeardownInstallComponentsRequest.
+type ServiceTeardownInstallComponentsRequest struct {
+	PlanOnly bool   `json:"plan_only,omitempty"`
+	Role     string `json:"role,omitempty"`
+}
+
+// ServiceUpdateActionWorkflowRequest is service.UpdateActionWorkflowRequest.
+type ServiceUpdateActionWorkflowRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+// ServiceUpdateAppConfigInstallsRequest is service.UpdateAppConfigInstallsRequest.
+type ServiceUpdateAppConfigInstallsRequest struct {
+	InstallIDs []string `json:"installIDs,omitempty"`
+	UpdateAll  bool     `json:"updateAll,omitempty"`
+}
+
+// ServiceUpdateAppConfigRequest is service.UpdateAppConfigRequest.
+type ServiceUpdateAppConfigRequest struct {
+	ComponentIDs      []string           `json:"component_ids,omitempty"`
+	State             string             `json:"state,omitempty"`
+	Status            AppAppConfigStatus `json:"status,omitempty"`
+	StatusDescription string             `json:"status_description,omitempty"`
+}
+
+// ServiceUpdateAppRequest is service.UpdateAppRequest.
+type ServiceUpdateAppRequest struct {
+	ConfigDirectory string `json:"config_directory,omitempty"`
+	ConfigRepo      string `json:"config_repo,omitempty"`
+	Description     string `json:"description,omitempty"`
+	DisplayName     string `json:"display_name,omitempty"`
+	Name            string `json:"name,omitempty"`
+	SlackWebhookURL string `json:"slack_webhook_url,omitempty"`
+}
+
+// ServiceUpdateComponentRequest is service.UpdateComponentRequest.
+type ServiceUpdateComponentRequest struct {
+	Dependencies []string `json:"dependencies,omitempty"`
+	Name         string   `json:"name"`
+	VarName      string   `json:"var_name,omitempty"`
+}
+
+// ServiceUpdateInstallConfigRequest is service.UpdateInstallConfigRequest.
+type ServiceUpdateInstallConfigRequest struct {
+	ApprovalOption AppInstallApprovalOption `json:"approval_option,omitempty"`
+}
+
+// ServiceUpdateInstallInputsRequest is service.UpdateInstallInputsRequest.
+type ServiceUpdateInstallInputsRequest struct {
+	Inputs map[string]string `json:"inputs"`
+}
+
+// ServiceUpdateInstallRequest is service.UpdateInstallRequest.
+type ServiceUpdateInstallRequest struct {
+	InstallConfig *ServicePatchInstallConfigParams `json:"install_config,omitempty"`
+	Metadata      *HelpersInstallMetadata          `json:"metadata,omitempty"`
+	Name          string                           `json:"name,omitempty"`
+}
+
+// ServiceUpdateOrgFeaturesRequest is service.UpdateOrgFeaturesRequest.
+type ServiceUpdateOrgFeaturesRequest struct {
+	Features map[string]bool `json:"features"`
+}
+
+// ServiceUpdateOrgRequest is service.UpdateOrgRequest.
+type ServiceUpdateOrgRequest struct {
+	Name string `json:"name"`
+}
+
+// ServiceUpdateRunnerSettingsRequest is service.UpdateRunnerSettingsRequest.
+type ServiceUpdateRunnerSettingsRequest struct {
+	AWSMaxInstanceLifetime   int64  `json:"aws_max_instance_lifetime,omitempty"`
+	ContainerImageTag        string `json:"container_image_tag,omitempty"`
+	ContainerImageURL        string `json:"container_image_url,omitempty"`
+	OrgAwsiamRoleARN         string `json:"org_awsiam_role_arn,omitempty"`
+	OrgK8sServiceAccountName string `json:"org_k8s_service_account_name,omitempty"`
+	RunnerAPIURL             string `json:"runner_api_url,omitempty"`
+}
+
+// ServiceUpdateUserJourneyStepRequest is service.UpdateUserJourneyStepRequest.
+type ServiceUpdateUserJourneyStepRequest struct {
+	Complete bool           `json:"complete,omitempty"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// ServiceUpdateWorkflowRequest is service.UpdateWorkflowRequest.
+type ServiceUpdateWorkflowRequest struct {
+	ApprovalOption AppInstallApprovalOption `json:"approval_option"`
+}
+
+// ServiceVCSConnectionAccount is service.VCSConnectionAccount.
+type ServiceVCSConnectionAccount struct {
+	ID    int64  `json:"id,omitempty"`
+	Login string `json:"login,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+// ServiceVCSConnectionRepo is service.VCSConnectionRepo.
+type ServiceVCSConnectionRepo struct {
+	DefaultBranch string `json:"default_branch,omitempty"`
+	Description   string `json:"description,omitempty"`
+	Fork          bool   `json:"fork,omitempty"`
+	FullName      string `json:"full_name,omitempty"`
+	HtmlURL       string `json:"html_url,omitempty"`
+	ID            int64  `json:"id,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Private       bool   `json:"private,omitempty"`
+	UpdatedAt     string `json:"updated_at,omitempty"`
+}
+
+// ServiceVCSConnectionReposResponse is service.VCSConnectionReposResponse.
+type ServiceVCSConnectionReposResponse struct {
+	Repositories []ServiceVCSConnectionRepo `json:"repositories,omitempty"`
+	TotalCount   int64                      `json:"total_count,omitempty"`
+}
+
+// ServiceVCSConnectionStatusResponse is service.VCSConnectionStatusResponse.
+type ServiceVCSConnectionStatusResponse struct {
+	Account             *ServiceVCSConnectionAccount `json:"account,omitempty"`
+	CheckedAt           string                       `json:"checked_at,omitempty"`
+	Error               string                       `json:"error,omitempty"`
+	GithubInstallID     string                       `json:"github_install_id,omitempty"`
+	Permissions         map[string]string            `json:"permissions,omitempty"`
+	RepositorySelection string                       `json:"repository_selection,omitempty"`
+	Status              string                       `json:"status,omitempty"`
+	SuspendedAt         string                       `json:"suspended_at,omitempty"`
+	SuspendedBy         *GithubUser                  `json:"suspended_by,omitempty"`
+}
+
+// ServiceWaitlistRequest is service.WaitlistRequest.
+type ServiceWaitlistRequest struct {
+	OrgName string `json:"org_name"`
+}
+
+// StateAWSCloudAccount is state.AWSCloudAccount.
+type StateAWSCloudAccount struct {
+	Region string `json:"region,omitempty"`
+}
+
+// StateActionWorkflowState is state.ActionWorkflowState.
+type StateActionWorkflowState struct {
+	ID        string         `json:"id,omitempty"`
+	Outputs   map[string]any `json:"outputs,omitempty"`
+	Populated bool           `json:"populated,omitempty"`
+	Status    string         `json:"status,omitempty"`
+}
+
+// StateActionsState is state.ActionsState.
+type StateActionsState struct {
+	Populated bool                                `json:"populated,omitempty"`
+	Workflows map[string]StateActionWorkflowState `json:"workflows,omitempty"`
+}
+
+// StateAppState is state.AppState.
+type StateAppState struct {
+	ID        string            `json:"id,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Populated bool              `json:"populated,omitempty"`
+	Status    string            `json:"status,omitempty"`
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// StateAzureCloudAccount is state.AzureCloudAccount.
+type StateAzureCloudAccount struct {
+	Location string `json:"location,omitempty"`
+}
+
+// StateCloudAccount is state.CloudAccount.
+type StateCloudAccount struct {
+	AWS   *StateAWSCloudAccount   `json:"aws,omitempty"`
+	Azure *StateAzureCloudAccount `json:"azure,omitempty"`
+}
+
+// StateDomainState is state.DomainState.
+type StateDomainState struct {
+	InternalDomain string `json:"internal_domain,omitempty"`
+	Populated      bool   `json:"populated,omitempty"`
+	PublicDomain   string `json:"public_domain,omitempty"`
+}
+
+// StateInputsState is state.InputsState.
+type StateInputsState struct {
+	Inputs    map[string]string `json:"inputs,omitempty"`
+	Populated bool              `json:"populated,omitempty"`
+}
+
+// StateInstallStackState is state.InstallStackState.
+type StateInstallStackState struct {
+	Checksum     string         `json:"checksum,omitempty"`
+	Outputs      map[string]any `json:"outputs,omitempty"`
+	Populated    bool           `json:"populated,omitempty"`
+	QuickLinkURL string         `json:"quick_link_url,omitempty"`
+	Status       string         `json:"status,omitempty"`
+	TemplateJSON string         `json:"template_json,omitempty"`
+	TemplateURL  string         `json:"template_url,omitempty"`
+}
+
+// StateInstallState is state.InstallState.
+type StateInstallState struct {
+	ID             string             `json:"id,omitempty"`
+	Inputs         map[string]string  `json:"inputs,omitempty"`
+	InternalDomain string             `json:"internal_domain,omitempty"`
+	Name           string             `json:"name,omitempty"`
+	Populated      bool               `json:"populated,omitempty"`
+	PublicDomain   string             `json:"public_domain,omitempty"`
+	Sandbox        *StateSandboxState `json:"sandbox,omitempty"`
+}
+
+// StateOrgState is state.OrgState.
+type StateOrgState struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Populated bool   `json:"populated,omitempty"`
+	Status    string `json:"status,omitempty"`
+}
+
+// StateRunnerState is state.RunnerState.
+type StateRunnerState struct {
+	ID            string `json:"id,omitempty"`
+	Populated     bool   `json:"populated,omitempty"`
+	RunnerGroupID string `json:"runner_group_id,omitempty"`
+	Status        string `json:"status,omitempty"`
+}
+
+// StateSandboxState is state.SandboxState.
+type StateSandboxState struct {
+	Outputs    map[string]any      `json:"outputs,omitempty"`
+	Populated  bool                `json:"populated,omitempty"`
+	RecentRuns []StateSandboxState `json:"recent_runs,omitempty"`
+	Status     string              `json:"status,omitempty"`
+	Type       string              `json:"type,omitempty"`
+	Version    string              `json:"version,omitempty"`
+}
+
+// StateSecretsState is state.SecretsState.
+type StateSecretsState map[string]OutputsSecretSyncOutput
+
+// StderrErrResponse is stderr.ErrResponse.
+type StderrErrResponse struct {
+	Description string `json:"description,omitempty"`
+	Error       string `json:"error,omitempty"`
+	UserError   bool   `json:"user_error,omitempty"`
+}
+
+// TypesStateState is github_com_nuonco_nuon_pkg_types_state.State.
+type TypesStateState struct {
+	Actions      *StateActionsState `json:"actions,omitempty"`
+	App          *StateAppState     `json:"app,omitempty"`
+	CloudAccount *StateCloudAccount `json:"cloud_account,omitempty"`
+	Components   map[string]any     `json:"components,omitempty"`
+	Domain       *StateDomainState  `json:"domain,omitempty"`
+	ID           string             `json:"id,omitempty"`
+	Inputs       *StateInputsState  `json:"inputs,omitempty"`
+	// NOTE: for backwards compatibility, these are remaining in place.
+	Install      *StateInstallState      `json:"install,omitempty"`
+	InstallStack *StateInstallStackState `json:"install_stack,omitempty"`
+	Name         string                  `json:"name,omitempty"`
+	Org          *StateOrgState          `json:"org,omitempty"`
+	Runner       *StateRunnerState       `json:"runner,omitempty"`
+	Sandbox      *StateSandboxState      `json:"sandbox,omitempty"`
+	Secrets      StateSecretsState       `json:"secrets,omitempty"`
+	// loaded from the database but not part of the state itself
+	StaleAt string `json:"stale_at,omitempty"`
+}
+
+// TypesStringBoolMap is types.StringBoolMap.
+type TypesStringBoolMap map[string]bool
+
+// AddUser calls POST /v1/orgs/current/user.
+//
+// Add a user to the current org
+func (c *Client) AddUser(ctx context.Context, body *ServiceCreateOrgUserRequest) (*AppAccount, error) {
+	var out AppAccount
+	if err := c.do(ctx, "POST", "/v1/orgs/current/user", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BuildAllComponents calls POST /v1/apps/{app_id}/components/build-all.
+//
+// create component build
+func (c *Client) BuildAllComponents(ctx context.Context, appID string, body any) ([]AppComponentBuild, error) {
+	var out []AppComponentBuild
+	err := c.do(ctx, "POST", "/v1/apps/{app_id}/components/build-all", map[string]string{"app_id": appID}, nil, body, &out)
+	return out, err
+}
+
+// CancelInstallWorkflow calls POST /v1/install-workflows/{install_workflow_id}/cancel.
+//
+// cancel an ongoing install workflow
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CancelInstallWorkflow(ctx context.Context, installWorkflowID string) (bool, error) {
+	var out bool
+	err := c.do(ctx, "POST", "/v1/install-workflows/{install_workflow_id}/cancel", map[string]string{"install_workflow_id": installWorkflowID}, nil, nil, &out)
+	return out, err
+}
+
+// CancelRunnerJob calls POST /v1/runner-jobs/{runner_job_id}/cancel.
+//
+// cancel runner job
+func (c *Client) CancelRunnerJob(ctx context.Context, runnerJobID string, body any) (*AppRunnerJob, error) {
+	var out AppRunnerJob
+	if err := c.do(ctx, "POST", "/v1/runner-jobs/{runner_job_id}/cancel", map[string]string{"runner_job_id": runnerJobID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelWorkflow calls POST /v1/workflows/{workflow_id}/cancel.
+//
+// cancel an ongoing workflow
+func (c *Client) CancelWorkflow(ctx context.Context, workflowID string) (bool, error) {
+	var out bool
+	err := c.do(ctx, "POST", "/v1/workflows/{workflow_id}/cancel", map[string]string{"workflow_id": workflowID}, nil, nil, &out)
+	return out, err
+}
+
+// CheckVCSConnectionStatus calls GET /v1/vcs/connections/{connection_id}/check-status.
+//
+// check the real-time status of a VCS connection
+func (c *Client) CheckVCSConnectionStatus(ctx context.Context, connectionID string) (*ServiceVCSConnectionStatusResponse, error) {
+	var out ServiceVCSConnectionStatusResponse
+	if err := c.do(ctx, "GET", "/v1/vcs/connections/{connection_id}/check-status", map[string]string{"connection_id": connectionID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CompleteUserJourney calls POST /v1/account/user-journeys/{journey_name}/complete.
+//
+// Complete all steps in a specific user journey
+func (c *Client) CompleteUserJourney(ctx context.Context, journeyName string) (*AppAccount, error) {
+	var out AppAccount
+	if err := c.do(ctx, "POST", "/v1/account/user-journeys/{journey_name}/complete", map[string]string{"journey_name": journeyName}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateActionConfig calls POST /v1/apps/{app_id}/actions/{action_id}/configs.
+//
+// create action config
+func (c *Client) CreateActionConfig(ctx context.Context, appID string, actionID string, body *ServiceCreateActionWorkflowConfigRequest) (*AppActionWorkflowConfig, error) {
+	var out AppActionWorkflowConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/actions/{action_id}/configs", map[string]string{"app_id": appID, "action_id": actionID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateActionWorkflowConfig calls POST /v1/action-workflows/{action_workflow_id}/configs.
+//
+// create action workflow config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateActionWorkflowConfig(ctx context.Context, actionWorkflowID string, body *ServiceCreateActionWorkflowConfigRequest) (*AppActionWorkflowConfig, error) {
+	var out AppActionWorkflowConfig
+	if err := c.do(ctx, "POST", "/v1/action-workflows/{action_workflow_id}/configs", map[string]string{"action_workflow_id": actionWorkflowID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAdHocAction calls POST /v1/installs/{install_id}/actions/adhoc-run.
+//
+// create an adhoc action run for an install
+func (c *Client) CreateAdHocAction(ctx context.Context, installID string, body *ServiceCreateAdHocActionRequest) (*ServiceCreateAdHocActionResponse, error) {
+	var out ServiceCreateAdHocActionResponse
+	if err := c.do(ctx, "POST", "/v1/installs/{install_id}/actions/adhoc-run", map[string]string{"install_id": installID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateApp calls POST /v1/apps.
+//
+// create an app
+func (c *Client) CreateApp(ctx context.Context, body *ServiceCreateAppRequest) (*AppApp, error) {
+	var out AppApp
+	if err := c.do(ctx, "POST", "/v1/apps", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppAction calls POST /v1/apps/{app_id}/actions.
+//
+// create an app action
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateAppAction(ctx context.Context, appID string, body *ServiceCreateAppActionRequest) (*AppActionWorkflow, error) {
+	var out AppActionWorkflow
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/actions", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppActionWorkflow calls POST /v1/apps/{app_id}/action-workflows.
+//
+// create an app action workflow
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateAppActionWorkflow(ctx context.Context, appID string, body *ServiceCreateAppActionWorkflowRequest) (*AppActionWorkflow, error) {
+	var out AppActionWorkflow
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/action-workflows", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppBranch calls POST /v1/apps/{app_id}/branches.
+//
+// POST /v1/apps/{app_id}/branches
+func (c *Client) CreateAppBranch(ctx context.Context, appID string, body *ServiceCreateAppBranchRequest) (*AppAppBranch, error) {
+	var out AppAppBranch
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/branches", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppBreakGlassConfig calls POST /v1/apps/{app_id}/break-glass-configs.
+//
+// POST /v1/apps/{app_id}/break-glass-configs
+func (c *Client) CreateAppBreakGlassConfig(ctx context.Context, appID string, body *ServiceCreateAppBreakGlassConfigRequest) (*AppAppBreakGlassConfig, error) {
+	var out AppAppBreakGlassConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/break-glass-configs", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppComponentBuild calls POST /v1/apps/{app_id}/components/{component_id}/builds.
+//
+// create component build
+func (c *Client) CreateAppComponentBuild(ctx context.Context, appID string, componentID string, body *ServiceCreateComponentBuildRequest) (*AppComponentBuild, error) {
+	var out AppComponentBuild
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/components/{component_id}/builds", map[string]string{"app_id": appID, "component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppConfig calls POST /v1/apps/{app_id}/config.
+//
+// POST /v1/apps/{app_id}/config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateAppConfig(ctx context.Context, appID string, body *ServiceCreateAppConfigRequest) (*AppAppConfig, error) {
+	var out AppAppConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/config", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppConfigV2 calls POST /v1/apps/{app_id}/configs.
+//
+// POST /v1/apps/{app_id}/configs
+func (c *Client) CreateAppConfigV2(ctx context.Context, appID string, body *ServiceCreateAppConfigRequest) (*AppAppConfig, error) {
+	var out AppAppConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/configs", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppDockerBuildComponentConfig calls POST /v1/apps/{app_id}/components/{component_id}/configs/docker-build.
+//
+// create a docker build component config
+func (c *Client) CreateAppDockerBuildComponentConfig(ctx context.Context, appID string, componentID string, body *ServiceCreateDockerBuildComponentConfigRequest) (*AppDockerBuildComponentConfig, error) {
+	var out AppDockerBuildComponentConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/components/{component_id}/configs/docker-build", map[string]string{"app_id": appID, "component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppExternalImageComponentConfig calls POST /v1/apps/{app_id}/components/{component_id}/configs/external-image.
+//
+// create an external image component config
+func (c *Client) CreateAppExternalImageComponentConfig(ctx context.Context, appID string, componentID string, body *ServiceCreateExternalImageComponentConfigRequest) (*AppExternalImageComponentConfig, error) {
+	var out AppExternalImageComponentConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/components/{component_id}/configs/external-image", map[string]string{"app_id": appID, "component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppHelmComponentConfig calls POST /v1/apps/{app_id}/components/{component_id}/configs/helm.
+//
+// create a helm component config
+func (c *Client) CreateAppHelmComponentConfig(ctx context.Context, appID string, componentID string, body *ServiceCreateHelmComponentConfigRequest) (*AppHelmComponentConfig, error) {
+	var out AppHelmComponentConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/components/{component_id}/configs/helm", map[string]string{"app_id": appID, "component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppInputConfig calls POST /v1/apps/{app_id}/input-config.
+//
+// POST /v1/apps/{app_id}/input-config
+func (c *Client) CreateAppInputConfig(ctx context.Context, appID string, body *ServiceCreateAppInputConfigRequest) (*AppAppInputConfig, error) {
+	var out AppAppInputConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/input-config", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppJobComponentConfig calls POST /v1/apps/{app_id}/components/{component_id}/configs/job.
+//
+// create a job component config
+func (c *Client) CreateAppJobComponentConfig(ctx context.Context, appID string, componentID string, body *ServiceCreateJobComponentConfigRequest) (*AppJobComponentConfig, error) {
+	var out AppJobComponentConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/components/{component_id}/configs/job", map[string]string{"app_id": appID, "component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppKubernetesManifestComponentConfig calls POST /v1/apps/{app_id}/components/{component_id}/configs/kubernetes-manifest.
+//
+// create a kubernetes manifest component config
+func (c *Client) CreateAppKubernetesManifestComponentConfig(ctx context.Context, appID string, componentID string, body *ServiceCreateKubernetesManifestComponentConfigRequest) (*AppKubernetesManifestComponentConfig, error) {
+	var out AppKubernetesManifestComponentConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/components/{component_id}/configs/kubernetes-manifest", map[string]string{"app_id": appID, "component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppOperationRoleConfig calls POST /v1/apps/{app_id}/operation-role-configs.
+//
+// create operation role config
+func (c *Client) CreateAppOperationRoleConfig(ctx context.Context, appID string, body *ServiceCreateAppOperationRoleConfigRequest) (*AppAppOperationRoleConfig, error) {
+	var out AppAppOperationRoleConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/operation-role-configs", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppPermissionsConfig calls POST /v1/apps/{app_id}/permissions-configs.
+//
+// POST /v1/apps/{app_id}/permissions-configs
+func (c *Client) CreateAppPermissionsConfig(ctx context.Context, appID string, body *ServiceCreateAppPermissionsConfigRequest) (*AppAppPermissionsConfig, error) {
+	var out AppAppPermissionsConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/permissions-configs", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppPoliciesConfig calls POST /v1/apps/{app_id}/policies-configs.
+//
+// POST /v1/apps/{app_id}/policies-configs
+func (c *Client) CreateAppPoliciesConfig(ctx context.Context, appID string, body *ServiceCreateAppPoliciesConfigRequest) (*AppAppPoliciesConfig, error) {
+	var out AppAppPoliciesConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/policies-configs", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppRunnerConfig calls POST /v1/apps/{app_id}/runner-configs.
+//
+// create an app runner config
+func (c *Client) CreateAppRunnerConfig(ctx context.Context, appID string, body *ServiceCreateAppRunnerConfigRequest) (*AppAppRunnerConfig, error) {
+	var out AppAppRunnerConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/runner-configs", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppSandboxConfig calls POST /v1/apps/{app_id}/sandbox-config.
+//
+// create an app sandbox config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateAppSandboxConfig(ctx context.Context, appID string, body *ServiceCreateAppSandboxConfigRequest) (*AppAppSandboxConfig, error) {
+	var out AppAppSandboxConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/sandbox-config", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppSandboxConfigV2 calls POST /v1/apps/{app_id}/sandbox-configs.
+//
+// create an app sandbox config
+func (c *Client) CreateAppSandboxConfigV2(ctx context.Context, appID string, body *ServiceCreateAppSandboxConfigRequest) (*AppAppSandboxConfig, error) {
+	var out AppAppSandboxConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/sandbox-configs", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppSecret calls POST /v1/apps/{app_id}/secret.
+//
+// create an app secret
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateAppSecret(ctx context.Context, appID string, body *ServiceCreateAppSecretRequest) (*AppAppSecret, error) {
+	var out AppAppSecret
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/secret", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppSecretV2 calls POST /v1/apps/{app_id}/secrets.
+//
+// create an app secret
+func (c *Client) CreateAppSecretV2(ctx context.Context, appID string, body *ServiceCreateAppSecretRequest) (*AppAppSecret, error) {
+	var out AppAppSecret
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/secrets", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppSecretsConfig calls POST /v1/apps/{app_id}/secrets-configs.
+//
+// POST /v1/apps/{app_id}/secrets-configs
+func (c *Client) CreateAppSecretsConfig(ctx context.Context, appID string, body *ServiceCreateAppSecretsConfigRequest) (*AppAppSecretsConfig, error) {
+	var out AppAppSecretsConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/secrets-configs", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppStackConfig calls POST /v1/apps/{app_id}/stack-configs.
+//
+// create an app stack config
+func (c *Client) CreateAppStackConfig(ctx context.Context, appID string, body *ServiceCreateAppStackConfigRequest) (*AppAppStackConfig, error) {
+	var out AppAppStackConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/stack-configs", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAppTerraformModuleComponentConfig calls POST /v1/apps/{app_id}/components/{component_id}/configs/terraform-module.
+//
+// create a terraform component config
+func (c *Client) CreateAppTerraformModuleComponentConfig(ctx context.Context, appID string, componentID string, body *ServiceCreateTerraformModuleComponentConfigRequest) (*AppTerraformModuleComponentConfig, error) {
+	var out AppTerraformModuleComponentConfig
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/components/{component_id}/configs/terraform-module", map[string]string{"app_id": appID, "component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateComponent calls POST /v1/apps/{app_id}/components.
+//
+// create a component
+func (c *Client) CreateComponent(ctx context.Context, appID string, body *ServiceCreateComponentRequest) (*AppComponent, error) {
+	var out AppComponent
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/components", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateComponentBuild calls POST /v1/components/{component_id}/builds.
+//
+// create component build
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateComponentBuild(ctx context.Context, componentID string, body *ServiceCreateComponentBuildRequest) (*AppComponentBuild, error) {
+	var out AppComponentBuild
+	if err := c.do(ctx, "POST", "/v1/components/{component_id}/builds", map[string]string{"component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateComponentRelease calls POST /v1/components/{component_id}/releases.
+//
+// create a release
+func (c *Client) CreateComponentRelease(ctx context.Context, componentID string, body *ServiceCreateComponentReleaseRequest) (*AppComponentRelease, error) {
+	var out AppComponentRelease
+	if err := c.do(ctx, "POST", "/v1/components/{component_id}/releases", map[string]string{"component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateDockerBuildComponentConfig calls POST /v1/components/{component_id}/configs/docker-build.
+//
+// create a docker build component config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateDockerBuildComponentConfig(ctx context.Context, componentID string, body *ServiceCreateDockerBuildComponentConfigRequest) (*AppDockerBuildComponentConfig, error) {
+	var out AppDockerBuildComponentConfig
+	if err := c.do(ctx, "POST", "/v1/components/{component_id}/configs/docker-build", map[string]string{"component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateExternalImageComponentConfig calls POST /v1/components/{component_id}/configs/external-image.
+//
+// create an external image component config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateExternalImageComponentConfig(ctx context.Context, componentID string, body *ServiceCreateExternalImageComponentConfigRequest) (*AppExternalImageComponentConfig, error) {
+	var out AppExternalImageComponentConfig
+	if err := c.do(ctx, "POST", "/v1/components/{component_id}/configs/external-image", map[string]string{"component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateHelmComponentConfig calls POST /v1/components/{component_id}/configs/helm.
+//
+// create a helm component config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateHelmComponentConfig(ctx context.Context, componentID string, body *ServiceCreateHelmComponentConfigRequest) (*AppHelmComponentConfig, error) {
+	var out AppHelmComponentConfig
+	if err := c.do(ctx, "POST", "/v1/components/{component_id}/configs/helm", map[string]string{"component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateInstall calls POST /v1/apps/{app_id}/installs.
+//
+// create an app install
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateInstall(ctx context.Context, appID string, body *ServiceCreateInstallRequest) (*AppInstall, error) {
+	var out AppInstall
+	if err := c.do(ctx, "POST", "/v1/apps/{app_id}/installs", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateInstallActionRun calls POST /v1/installs/{install_id}/actions/runs.
+//
+// create an action run for an install
+func (c *Client) CreateInstallActionRun(ctx context.Context, installID string, body *ServiceCreateInstallActionWorkflowRunRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/actions/runs", map[string]string{"install_id": installID}, nil, body, &out)
+	return out, err
+}
+
+// CreateInstallActionWorkflowRun calls POST /v1/installs/{install_id}/action-workflows/runs.
+//
+// create an action workflow run for an install
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateInstallActionWorkflowRun(ctx context.Context, installID string, body *ServiceCreateInstallActionWorkflowRunRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/action-workflows/runs", map[string]string{"install_id": installID}, nil, body, &out)
+	return out, err
+}
+
+// CreateInstallComponentDeploy calls POST /v1/installs/{install_id}/components/{component_id}/deploys.
+//
+// deploy a build to an install
+func (c *Client) CreateInstallComponentDeploy(ctx context.Context, installID string, componentID string, body *ServiceCreateInstallComponentDeployRequest) (*AppInstallDeploy, error) {
+	var out AppInstallDeploy
+	if err := c.do(ctx, "POST", "/v1/installs/{install_id}/components/{component_id}/deploys", map[string]string{"install_id": installID, "component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateInstallConfig calls POST /v1/installs/{install_id}/configs.
+//
+// create an install config
+func (c *Client) CreateInstallConfig(ctx context.Context, installID string, body *ServiceCreateInstallConfigRequest) (*AppInstallConfig, error) {
+	var out AppInstallConfig
+	if err := c.do(ctx, "POST", "/v1/installs/{install_id}/configs", map[string]string{"install_id": installID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateInstallDeploy calls POST /v1/installs/{install_id}/deploys.
+//
+// deploy a build to an install
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateInstallDeploy(ctx context.Context, installID string, body *ServiceCreateInstallDeployRequest) (*AppInstallDeploy, error) {
+	var out AppInstallDeploy
+	if err := c.do(ctx, "POST", "/v1/installs/{install_id}/deploys", map[string]string{"install_id": installID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateInstallInputs calls POST /v1/installs/{install_id}/inputs.
+//
+// create install inputs
+func (c *Client) CreateInstallInputs(ctx context.Context, installID string, body *ServiceCreateInstallInputsRequest) (*AppInstallInputs, error) {
+	var out AppInstallInputs
+	if err := c.do(ctx, "POST", "/v1/installs/{install_id}/inputs", map[string]string{"install_id": installID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateInstallV2 calls POST /v1/installs.
+//
+// create an app install
+func (c *Client) CreateInstallV2(ctx context.Context, body *ServiceCreateInstallV2Request) (*AppInstall, error) {
+	var out AppInstall
+	if err := c.do(ctx, "POST", "/v1/installs", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateJobComponentConfig calls POST /v1/components/{component_id}/configs/job.
+//
+// create a job component config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateJobComponentConfig(ctx context.Context, componentID string, body *ServiceCreateJobComponentConfigRequest) (*AppJobComponentConfig, error) {
+	var out AppJobComponentConfig
+	if err := c.do(ctx, "POST", "/v1/components/{component_id}/configs/job", map[string]string{"component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateKubernetesManifestComponentConfig calls POST /v1/components/{component_id}/configs/kubernetes-manifest.
+//
+// create a kubernetes manifest component config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateKubernetesManifestComponentConfig(ctx context.Context, componentID string, body *ServiceCreateKubernetesManifestComponentConfigRequest) (*AppKubernetesManifestComponentConfig, error) {
+	var out AppKubernetesManifestComponentConfig
+	if err := c.do(ctx, "POST", "/v1/components/{component_id}/configs/kubernetes-manifest", map[string]string{"component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateOrg calls POST /v1/orgs.
+//
+// create a new org
+func (c *Client) CreateOrg(ctx context.Context, body *ServiceCreateOrgRequest) (*AppOrg, error) {
+	var out AppOrg
+	if err := c.do(ctx, "POST", "/v1/orgs", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateOrgInvite calls POST /v1/orgs/current/invites.
+//
+// Invite a user to the current org
+func (c *Client) CreateOrgInvite(ctx context.Context, body *ServiceCreateOrgInviteRequest) (*AppOrgInvite, error) {
+	var out AppOrgInvite
+	if err := c.do(ctx, "POST", "/v1/orgs/current/invites", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTerraformModuleComponentConfig calls POST /v1/components/{component_id}/configs/terraform-module.
+//
+// create a terraform component config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) CreateTerraformModuleComponentConfig(ctx context.Context, componentID string, body *ServiceCreateTerraformModuleComponentConfigRequest) (*AppTerraformModuleComponentConfig, error) {
+	var out AppTerraformModuleComponentConfig
+	if err := c.do(ctx, "POST", "/v1/components/{component_id}/configs/terraform-module", map[string]string{"component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTerraformWorkspace calls POST /v1/terraform-workspace.
+//
+// create terraform workspace
+func (c *Client) CreateTerraformWorkspace(ctx context.Context, body *ServiceCreateTerraformWorkspaceRequest) (*AppTerraformWorkspace, error) {
+	var out AppTerraformWorkspace
+	if err := c.do(ctx, "POST", "/v1/terraform-workspace", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTerraformWorkspaceV2 calls POST /v1/terraform-workspaces.
+//
+// create terraform workspace
+func (c *Client) CreateTerraformWorkspaceV2(ctx context.Context, body *ServiceCreateTerraformWorkspaceRequest) (*AppTerraformWorkspace, error) {
+	var out AppTerraformWorkspace
+	if err := c.do(ctx, "POST", "/v1/terraform-workspaces", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateUserJourney calls POST /v1/account/user-journeys.
+//
+// Create a new user journey for account
+func (c *Client) CreateUserJourney(ctx context.Context, body *ServiceCreateUserJourneyRequest) (*AppAccount, error) {
+	var out AppAccount
+	if err := c.do(ctx, "POST", "/v1/account/user-journeys", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateVCSConnection calls POST /v1/vcs/connections.
+//
+// create a vcs connection for Github
+func (c *Client) CreateVCSConnection(ctx context.Context, body *ServiceCreateConnectionRequest) (*AppVCSConnection, error) {
+	var out AppVCSConnection
+	if err := c.do(ctx, "POST", "/v1/vcs/connections", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateVCSConnectionCallback calls POST /v1/vcs/connection-callback.
+//
+// public connection to create a vcs connection via a callback
+func (c *Client) CreateVCSConnectionCallback(ctx context.Context, body *ServiceCreateConnectionCallbackRequest) (*AppVCSConnection, error) {
+	var out AppVCSConnection
+	if err := c.do(ctx, "POST", "/v1/vcs/connection-callback", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateWaitlist calls POST /v1/general/waitlist.
+//
+// Allow user to be added to an org waitlist.
+func (c *Client) CreateWaitlist(ctx context.Context, body *ServiceWaitlistRequest) (*AppWaitlist, error) {
+	var out AppWaitlist
+	if err := c.do(ctx, "POST", "/v1/general/waitlist", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateWorkflowStepApprovalResponse calls POST /v1/workflows/{workflow_id}/steps/{step_id}/approvals/{approval_id}/response.
+//
+// Create an approval response for a workflow step.
+func (c *Client) CreateWorkflowStepApprovalResponse(ctx context.Context, workflowID string, stepID string, approvalID string, body *ServiceCreateWorkflowStepApprovalResponseRequest) (*ServiceCreateWorkflowStepApprovalResponseResponse, error) {
+	var out ServiceCreateWorkflowStepApprovalResponseResponse
+	if err := c.do(ctx, "POST", "/v1/workflows/{workflow_id}/steps/{step_id}/approvals/{approval_id}/response", map[string]string{"workflow_id": workflowID, "step_id": stepID, "approval_id": approvalID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteAction calls DELETE /v1/apps/{app_id}/actions/{action_id}.
+//
+// delete an action
+func (c *Client) DeleteAction(ctx context.Context, appID string, actionID string) (bool, error) {
+	var out bool
+	err := c.do(ctx, "DELETE", "/v1/apps/{app_id}/actions/{action_id}", map[string]string{"app_id": appID, "action_id": actionID}, nil, nil, &out)
+	return out, err
+}
+
+// DeleteActionWorkflow calls DELETE /v1/action-workflows/{action_workflow_id}.
+//
+// delete an action workflow
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) DeleteActionWorkflow(ctx context.Context, actionWorkflowID string) (bool, error) {
+	var out bool
+	err := c.do(ctx, "DELETE", "/v1/action-workflows/{action_workflow_id}", map[string]string{"action_workflow_id": actionWorkflowID}, nil, nil, &out)
+	return out, err
+}
+
+// DeleteApp calls DELETE /v1/apps/{app_id}.
+//
+// delete an app
+func (c *Client) DeleteApp(ctx context.Context, appID string) (bool, error) {
+	var out bool
+	err := c.do(ctx, "DELETE", "/v1/apps/{app_id}", map[string]string{"app_id": appID}, nil, nil, &out)
+	return out, err
+}
+
+// DeleteAppComponent calls DELETE /v1/apps/{app_id}/components/{component_id}.
+//
+// delete a component
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) DeleteAppComponent(ctx context.Context, appID string, componentID string) (bool, error) {
+	var out bool
+	err := c.do(ctx, "DELETE", "/v1/apps/{app_id}/components/{component_id}", map[string]string{"app_id": appID, "component_id": componentID}, nil, nil, &out)
+	return out, err
+}
+
+// DeleteAppSecret calls DELETE /v1/apps/{app_id}/secret/{secret_id}.
+//
+// delete an app secret
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) DeleteAppSecret(ctx context.Context, appID string, secretID string) (bool, error) {
+	var out bool
+	err := c.do(ctx, "DELETE", "/v1/apps/{app_id}/secret/{secret_id}", map[string]string{"app_id": appID, "secret_id": secretID}, nil, nil, &out)
+	return out, err
+}
+
+// DeleteAppSecretV2 calls DELETE /v1/apps/{app_id}/secrets/{secret_id}.
+//
+// delete an app secret
+func (c *Client) DeleteAppSecretV2(ctx context.Context, appID string, secretID string) (bool, error) {
+	var out bool
+	err := c.do(ctx, "DELETE", "/v1/apps/{app_id}/secrets/{secret_id}", map[string]string{"app_id": appID, "secret_id": secretID}, nil, nil, &out)
+	return out, err
+}
+
+// DeleteComponent calls DELETE /v1/components/{component_id}.
+//
+// delete a component
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) DeleteComponent(ctx context.Context, componentID string) (bool, error) {
+	var out bool
+	err := c.do(ctx, "DELETE", "/v1/components/{component_id}", map[string]string{"component_id": componentID}, nil, nil, &out)
+	return out, err
+}
+
+// DeleteInstall calls DELETE /v1/installs/{install_id}.
+//
+// delete an install
+func (c *Client) DeleteInstall(ctx context.Context, installID string) (bool, error) {
+	var out bool
+	err := c.do(ctx, "DELETE", "/v1/installs/{install_id}", map[string]string{"install_id": installID}, nil, nil, &out)
+	return out, err
+}
+
+// DeleteOrg calls DELETE /v1/orgs/current.
+//
+// Delete an org
+func (c *Client) DeleteOrg(ctx context.Context) (bool, error) {
+	var out bool
+	err := c.do(ctx, "DELETE", "/v1/orgs/current", nil, nil, nil, &out)
+	return out, err
+}
+
+// DeleteTerraformWorkspace calls DELETE /v1/terraform-workspaces/{workspace_id}.
+//
+// delete terraform workspace
+func (c *Client) DeleteTerraformWorkspace(ctx context.Context, workspaceID string) ([]AppTerraformWorkspace, error) {
+	var out []AppTerraformWorkspace
+	err := c.do(ctx, "DELETE", "/v1/terraform-workspaces/{workspace_id}", map[string]string{"workspace_id": workspaceID}, nil, nil, &out)
+	return out, err
+}
+
+// DeleteVCSConnection calls DELETE /v1/vcs/connections/{connection_id}.
+//
+// Deletes a VCS connection
+func (c *Client) DeleteVCSConnection(ctx context.Context, connectionID string) error {
+	return c.do(ctx, "DELETE", "/v1/vcs/connections/{connection_id}", map[string]string{"connection_id": connectionID}, nil, nil, nil)
+}
+
+// DeployInstallComponents calls POST /v1/installs/{install_id}/components/deploy-all.
+//
+// deploy all components on an install
+func (c *Client) DeployInstallComponents(ctx context.Context, installID string, body *ServiceDeployInstallComponentsRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/components/deploy-all", map[string]string{"install_id": installID}, nil, body, &out)
+	return out, err
+}
+
+// DeprovisionInstall calls POST /v1/installs/{install_id}/deprovision.
+//
+// deprovision an install
+func (c *Client) DeprovisionInstall(ctx context.Context, installID string, body *ServiceDeprovisionInstallRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/deprovision", map[string]string{"install_id": installID}, nil, body, &out)
+	return out, err
+}
+
+// DeprovisionInstallSandbox calls POST /v1/installs/{install_id}/deprovision-sandbox.
+//
+// deprovision an install
+func (c *Client) DeprovisionInstallSandbox(ctx context.Context, installID string, body *ServiceDeprovisionInstallSandboxRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/deprovision-sandbox", map[string]string{"install_id": installID}, nil, body, &out)
+	return out, err
+}
+
+// FetchRunnerTokenMng calls POST /v1/runners/{runner_id}/mng/fetch-token.
+//
+// fetch authentication token for an install runner via the mng process
+func (c *Client) FetchRunnerTokenMng(ctx context.Context, runnerID string, body any) (bool, error) {
+	var out bool
+	err := c.do(ctx, "POST", "/v1/runners/{runner_id}/mng/fetch-token", map[string]string{"runner_id": runnerID}, nil, body, &out)
+	return out, err
+}
+
+// ForceShutDownRunner calls POST /v1/runners/{runner_id}/force-shutdown.
+//
+// force shut down a runner
+func (c *Client) ForceShutDownRunner(ctx context.Context, runnerID string, body any) (bool, error) {
+	var out bool
+	err := c.do(ctx, "POST", "/v1/runners/{runner_id}/force-shutdown", map[string]string{"runner_id": runnerID}, nil, body, &out)
+	return out, err
+}
+
+// ForgetInstall calls POST /v1/installs/{install_id}/forget.
+//
+// forget an install
+func (c *Client) ForgetInstall(ctx context.Context, installID string, body any) (bool, error) {
+	var out bool
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/forget", map[string]string{"install_id": installID}, nil, body, &out)
+	return out, err
+}
+
+// ForgetInstallComponent calls POST /v1/installs/{install_id}/components/{component_id}/forget.
+//
+// forget an install component
+func (c *Client) ForgetInstallComponent(ctx context.Context, installID string, componentID string, body any) (bool, error) {
+	var out bool
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/components/{component_id}/forget", map[string]string{"install_id": installID, "component_id": componentID}, nil, body, &out)
+	return out, err
+}
+
+// GenerateCLIInstallConfig calls GET /v1/installs/{install_id}/generate-cli-install-config.
+//
+// generate an install config to be used with CLI
+func (c *Client) GenerateCLIInstallConfig(ctx context.Context, installID string) ([]byte, error) {
+	var out []byte
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/generate-cli-install-config", map[string]string{"install_id": installID}, nil, nil, &out)
+	return out, err
+}
+
+// GenerateTerraformInstallerConfig calls GET /v1/installs/{install_id}/generate-terraform-installer-config.
+//
+// generate a Terraform installer config
+func (c *Client) GenerateTerraformInstallerConfig(ctx context.Context, installID string) ([]byte, error) {
+	var out []byte
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/generate-terraform-installer-config", map[string]string{"install_id": installID}, nil, nil, &out)
+	return out, err
+}
+
+// GetActionLatestConfig calls GET /v1/apps/{app_id}/actions/{action_id}/latest-config.
+//
+// get an app action workflow's latest config
+func (c *Client) GetActionLatestConfig(ctx context.Context, appID string, actionID string) (*AppActionWorkflowConfig, error) {
+	var out AppActionWorkflowConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/actions/{action_id}/latest-config", map[string]string{"app_id": appID, "action_id": actionID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetActionWorkflow calls GET /v1/action-workflows/{action_workflow_id}.
+//
+// get an app action workflow by action workflow id
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetActionWorkflow(ctx context.Context, actionWorkflowID string) (*AppActionWorkflow, error) {
+	var out AppActionWorkflow
+	if err := c.do(ctx, "GET", "/v1/action-workflows/{action_workflow_id}", map[string]string{"action_workflow_id": actionWorkflowID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetActionWorkflowConfig calls GET /v1/action-workflows/configs/{action_workflow_config_id}.
+//
+// get an app action workflow config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetActionWorkflowConfig(ctx context.Context, actionWorkflowConfigID string) (*AppActionWorkflowConfig, error) {
+	var out AppActionWorkflowConfig
+	if err := c.do(ctx, "GET", "/v1/action-workflows/configs/{action_workflow_config_id}", map[string]string{"action_workflow_config_id": actionWorkflowConfigID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetActionWorkflowConfigsParams holds the query parameters of GET /v1/action-workflows/{action_workflow_id}/configs. Zero values are not sent.
+type GetActionWorkflowConfigsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetActionWorkflowConfigsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetActionWorkflowConfigs calls GET /v1/action-workflows/{action_workflow_id}/configs.
+//
+// get action workflow for an app
+func (c *Client) GetActionWorkflowConfigs(ctx context.Context, actionWorkflowID string, params *GetActionWorkflowConfigsParams) ([]AppActionWorkflowConfig, error) {
+	var out []AppActionWorkflowConfig
+	err := c.do(ctx, "GET", "/v1/action-workflows/{action_workflow_id}/configs", map[string]string{"action_workflow_id": actionWorkflowID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetActionWorkflowLatestConfig calls GET /v1/action-workflows/{action_workflow_id}/latest-config.
+//
+// get an app action workflow's latest config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetActionWorkflowLatestConfig(ctx context.Context, actionWorkflowID string) (*AppActionWorkflowConfig, error) {
+	var out AppActionWorkflowConfig
+	if err := c.do(ctx, "GET", "/v1/action-workflows/{action_workflow_id}/latest-config", map[string]string{"action_workflow_id": actionWorkflowID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetActionWorkflowsParams holds the query parameters of GET /v1/apps/{app_id}/action-workflows. Zero values are not sent.
+type GetActionWorkflowsParams struct {
+	// search query to filter action workflows by name
+	Q string
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetActionWorkflowsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Q != "" {
+		q.Set("q", p.Q)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetActionWorkflows calls GET /v1/apps/{app_id}/action-workflows.
+//
+// get action workflows for an app
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetActionWorkflows(ctx context.Context, appID string, params *GetActionWorkflowsParams) ([]AppActionWorkflow, error) {
+	var out []AppActionWorkflow
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/action-workflows", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetApp calls GET /v1/apps/{app_id}.
+//
+// get an app
+func (c *Client) GetApp(ctx context.Context, appID string) (*AppApp, error) {
+	var out AppApp
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}", map[string]string{"app_id": appID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppAction calls GET /v1/apps/{app_id}/actions/{action_id}.
+//
+// get an app action workflow by action workflow id
+func (c *Client) GetAppAction(ctx context.Context, appID string, actionID string) (*AppActionWorkflow, error) {
+	var out AppActionWorkflow
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/actions/{action_id}", map[string]string{"app_id": appID, "action_id": actionID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppActionConfig calls GET /v1/apps/{app_id}/actions/configs/{action_config_id}.
+//
+// get an app action config
+func (c *Client) GetAppActionConfig(ctx context.Context, appID string, actionConfigID string) (*AppActionWorkflowConfig, error) {
+	var out AppActionWorkflowConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/actions/configs/{action_config_id}", map[string]string{"app_id": appID, "action_config_id": actionConfigID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppActionConfigsParams holds the query parameters of GET /v1/apps/{app_id}/actions/{action_id}/configs. Zero values are not sent.
+type GetAppActionConfigsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppActionConfigsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppActionConfigs calls GET /v1/apps/{app_id}/actions/{action_id}/configs.
+//
+// get action workflow for an app
+func (c *Client) GetAppActionConfigs(ctx context.Context, appID string, actionID string, params *GetAppActionConfigsParams) ([]AppActionWorkflowConfig, error) {
+	var out []AppActionWorkflowConfig
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/actions/{action_id}/configs", map[string]string{"app_id": appID, "action_id": actionID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppActionWorkflow calls GET /v1/apps/{app_id}/action-workflows/{action_workflow_id}.
+//
+// get an app action workflow
+func (c *Client) GetAppActionWorkflow(ctx context.Context, appID string, actionWorkflowID string) (*AppActionWorkflow, error) {
+	var out AppActionWorkflow
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/action-workflows/{action_workflow_id}", map[string]string{"app_id": appID, "action_workflow_id": actionWorkflowID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppActionsParams holds the query parameters of GET /v1/apps/{app_id}/actions. Zero values are not sent.
+type GetAppActionsParams struct {
+	// search query to filter action workflows by name
+	Q string
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppActionsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Q != "" {
+		q.Set("q", p.Q)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppActions calls GET /v1/apps/{app_id}/actions.
+//
+// get action workflows for an app
+func (c *Client) GetAppActions(ctx context.Context, appID string, params *GetAppActionsParams) ([]AppActionWorkflow, error) {
+	var out []AppActionWorkflow
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/actions", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppBranchAppConfigsParams holds the query parameters of GET /v1/apps/{app_id}/branches/{app_branch_id}/configs. Zero values are not sent.
+type GetAppBranchAppConfigsParams struct {
+	// offset of branches to return
+	Offset int64
+	// limit of branches to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppBranchAppConfigsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppBranchAppConfigs calls GET /v1/apps/{app_id}/branches/{app_branch_id}/configs.
+//
+// get app branch app configs
+func (c *Client) GetAppBranchAppConfigs(ctx context.Context, appID string, appBranchID string, params *GetAppBranchAppConfigsParams) ([]AppAppConfig, error) {
+	var out []AppAppConfig
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/branches/{app_branch_id}/configs", map[string]string{"app_id": appID, "app_branch_id": appBranchID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppBranchesParams holds the query parameters of GET /v1/apps/{app_id}/branches. Zero values are not sent.
+type GetAppBranchesParams struct {
+	// offset of branches to return
+	Offset int64
+	// limit of branches to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppBranchesParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppBranches calls GET /v1/apps/{app_id}/branches.
+//
+// get app branches
+func (c *Client) GetAppBranches(ctx context.Context, appID string, params *GetAppBranchesParams) ([]AppAppBranch, error) {
+	var out []AppAppBranch
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/branches", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppBreakGlassConfig calls GET /v1/apps/{app_id}/break-glass-configs/{config_id}.
+//
+// get app break_glass config
+func (c *Client) GetAppBreakGlassConfig(ctx context.Context, appID string, configID string) (*AppAppBreakGlassConfig, error) {
+	var out AppAppBreakGlassConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/break-glass-configs/{config_id}", map[string]string{"app_id": appID, "config_id": configID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppComponent calls GET /v1/apps/{app_id}/component/{component_name_or_id}.
+//
+// get a components for a specific app
+func (c *Client) GetAppComponent(ctx context.Context, appID string, componentNameOrID string) (*AppComponent, error) {
+	var out AppComponent
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/component/{component_name_or_id}", map[string]string{"app_id": appID, "component_name_or_id": componentNameOrID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppComponentBuild calls GET /v1/apps/{app_id}/components/{component_id}/builds/{build_id}.
+//
+// get a build for a component
+func (c *Client) GetAppComponentBuild(ctx context.Context, appID string, componentID string, buildID string) (*AppComponentBuild, error) {
+	var out AppComponentBuild
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/components/{component_id}/builds/{build_id}", map[string]string{"app_id": appID, "component_id": componentID, "build_id": buildID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppComponentBuildsParams holds the query parameters of GET /v1/apps/{app_id}/components/{component_id}/builds. Zero values are not sent.
+type GetAppComponentBuildsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppComponentBuildsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppComponentBuilds calls GET /v1/apps/{app_id}/components/{component_id}/builds.
+//
+// get builds for components
+func (c *Client) GetAppComponentBuilds(ctx context.Context, appID string, componentID string, params *GetAppComponentBuildsParams) ([]AppComponentBuild, error) {
+	var out []AppComponentBuild
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/components/{component_id}/builds", map[string]string{"app_id": appID, "component_id": componentID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppComponentConfig calls GET /v1/apps/{app_id}/components/{component_id}/configs/{config_id}.
+//
+// get a config for a component
+func (c *Client) GetAppComponentConfig(ctx context.Context, appID string, componentID string, configID string) (*AppComponentConfigConnection, error) {
+	var out AppComponentConfigConnection
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/components/{component_id}/configs/{config_id}", map[string]string{"app_id": appID, "component_id": componentID, "config_id": configID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppComponentConfigsParams holds the query parameters of GET /v1/apps/{app_id}/components/{component_id}/configs. Zero values are not sent.
+type GetAppComponentConfigsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppComponentConfigsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppComponentConfigs calls GET /v1/apps/{app_id}/components/{component_id}/configs.
+//
+// get all configs for a component
+func (c *Client) GetAppComponentConfigs(ctx context.Context, appID string, componentID string, params *GetAppComponentConfigsParams) ([]AppComponentConfigConnection, error) {
+	var out []AppComponentConfigConnection
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/components/{component_id}/configs", map[string]string{"app_id": appID, "component_id": componentID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppComponentDependencies calls GET /v1/apps/{app_id}/components/{component_id}/dependencies.
+//
+// get a component's dependencies
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetAppComponentDependencies(ctx context.Context, appID string, componentID string) ([]AppComponent, error) {
+	var out []AppComponent
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/components/{component_id}/dependencies", map[string]string{"app_id": appID, "component_id": componentID}, nil, nil, &out)
+	return out, err
+}
+
+// GetAppComponentDependents calls GET /v1/apps/{app_id}/components/{component_id}/dependents.
+//
+// get a component's children
+func (c *Client) GetAppComponentDependents(ctx context.Context, appID string, componentID string) (*ServiceComponentChildren, error) {
+	var out ServiceComponentChildren
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/components/{component_id}/dependents", map[string]string{"app_id": appID, "component_id": componentID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppComponentLatestBuild calls GET /v1/apps/{app_id}/components/{component_id}/builds/latest.
+//
+// get latest build for a component
+func (c *Client) GetAppComponentLatestBuild(ctx context.Context, appID string, componentID string) (*AppComponentBuild, error) {
+	var out AppComponentBuild
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/components/{component_id}/builds/latest", map[string]string{"app_id": appID, "component_id": componentID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppComponentLatestConfig calls GET /v1/apps/{app_id}/components/{component_id}/configs/latest.
+//
+// get latest config for a component
+func (c *Client) GetAppComponentLatestConfig(ctx context.Context, appID string, componentID string) (*AppComponentConfigConnection, error) {
+	var out AppComponentConfigConnection
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/components/{component_id}/configs/latest", map[string]string{"app_id": appID, "component_id": componentID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppComponentsParams holds the query parameters of GET /v1/apps/{app_id}/components. Zero values are not sent.
+type GetAppComponentsParams struct {
+	// search query to filter components by name
+	Q string
+	// comma-separated list of component types to filter by (e.g., terraform_module, helm_chart)
+	Types string
+	// comma-separated list of component IDs to filter by
+	ComponentIDs string
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppComponentsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Q != "" {
+		q.Set("q", p.Q)
+	}
+	if p.Types != "" {
+		q.Set("types", p.Types)
+	}
+	if p.ComponentIDs != "" {
+		q.Set("component_ids", p.ComponentIDs)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppComponents calls GET /v1/apps/{app_id}/components.
+//
+// get all components for an app
+func (c *Client) GetAppComponents(ctx context.Context, appID string, params *GetAppComponentsParams) ([]AppComponent, error) {
+	var out []AppComponent
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/components", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppConfigParams holds the query parameters of GET /v1/apps/{app_id}/config/{app_config_id}. Zero values are not sent.
+type GetAppConfigParams struct {
+	// load all children configs
+	Recurse bool
+}
+
+func (p *GetAppConfigParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Recurse {
+		q.Set("recurse", "true")
+	}
+	return q
+}
+
+// GetAppConfig calls GET /v1/apps/{app_id}/config/{app_config_id}.
+//
+// get an app config
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetAppConfig(ctx context.Context, appID string, appConfigID string, params *GetAppConfigParams) (*AppAppConfig, error) {
+	var out AppAppConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/config/{app_config_id}", map[string]string{"app_id": appID, "app_config_id": appConfigID}, params.values(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppConfigGraph calls GET /v1/apps/{app_id}/config/{app_config_id}/graph.
+//
+// get an app config graph
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetAppConfigGraph(ctx context.Context, appID string, appConfigID string) (string, error) {
+	var out string
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/config/{app_config_id}/graph", map[string]string{"app_id": appID, "app_config_id": appConfigID}, nil, nil, &out)
+	return out, err
+}
+
+// GetAppConfigGraphV2 calls GET /v1/apps/{app_id}/configs/{config_id}/graph.
+//
+// get an app config graph
+func (c *Client) GetAppConfigGraphV2(ctx context.Context, appID string, configID string) (string, error) {
+	var out string
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/configs/{config_id}/graph", map[string]string{"app_id": appID, "config_id": configID}, nil, nil, &out)
+	return out, err
+}
+
+// GetAppConfigTemplateParams holds the query parameters of GET /v1/apps/{app_id}/template-config. Zero values are not sent.
+type GetAppConfigTemplateParams struct {
+	// app template type
+	Type string
+}
+
+func (p *GetAppConfigTemplateParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Type != "" {
+		q.Set("type", p.Type)
+	}
+	return q
+}
+
+// GetAppConfigTemplate calls GET /v1/apps/{app_id}/template-config.
+//
+// get an app config template
+func (c *Client) GetAppConfigTemplate(ctx context.Context, appID string, params *GetAppConfigTemplateParams) (*ServiceAppConfigTemplate, error) {
+	var out ServiceAppConfigTemplate
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/template-config", map[string]string{"app_id": appID}, params.values(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppConfigsParams holds the query parameters of GET /v1/apps/{app_id}/configs. Zero values are not sent.
+type GetAppConfigsParams struct {
+	// offset of jobs to return
+	Offset int64
+	// limit of jobs to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppConfigsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppConfigs calls GET /v1/apps/{app_id}/configs.
+//
+// get app configs
+func (c *Client) GetAppConfigs(ctx context.Context, appID string, params *GetAppConfigsParams) ([]AppAppConfig, error) {
+	var out []AppAppConfig
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/configs", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppConflgV2Params holds the query parameters of GET /v1/apps/{app_id}/configs/{config_id}. Zero values are not sent.
+type GetAppConflgV2Params struct {
+	// load all children configs
+	Recurse bool
+}
+
+func (p *GetAppConflgV2Params) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Recurse {
+		q.Set("recurse", "true")
+	}
+	return q
+}
+
+// GetAppConflgV2 calls GET /v1/apps/{app_id}/configs/{config_id}.
+//
+// get an app config
+func (c *Client) GetAppConflgV2(ctx context.Context, appID string, configID string, params *GetAppConflgV2Params) (*AppAppConfig, error) {
+	var out AppAppConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/configs/{config_id}", map[string]string{"app_id": appID, "config_id": configID}, params.values(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppInputConfig calls GET /v1/apps/{app_id}/input-configs/{input_config_id}.
+//
+// get app input config
+func (c *Client) GetAppInputConfig(ctx context.Context, appID string, inputConfigID string) (*AppAppInputConfig, error) {
+	var out AppAppInputConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/input-configs/{input_config_id}", map[string]string{"app_id": appID, "input_config_id": inputConfigID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppInputConfigsParams holds the query parameters of GET /v1/apps/{app_id}/input-configs. Zero values are not sent.
+type GetAppInputConfigsParams struct {
+	// offset of jobs to return
+	Offset int64
+	// limit of jobs to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppInputConfigsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppInputConfigs calls GET /v1/apps/{app_id}/input-configs.
+//
+// get app input configs
+func (c *Client) GetAppInputConfigs(ctx context.Context, appID string, params *GetAppInputConfigsParams) ([]AppAppInputConfig, error) {
+	var out []AppAppInputConfig
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/input-configs", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppInputLatestConfig calls GET /v1/apps/{app_id}/input-latest-config.
+//
+// get latest app input config
+func (c *Client) GetAppInputLatestConfig(ctx context.Context, appID string) (*AppAppInputConfig, error) {
+	var out AppAppInputConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/input-latest-config", map[string]string{"app_id": appID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppInstallsParams holds the query parameters of GET /v1/apps/{app_id}/installs. Zero values are not sent.
+type GetAppInstallsParams struct {
+	// search query
+	Q string
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppInstallsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Q != "" {
+		q.Set("q", p.Q)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppInstalls calls GET /v1/apps/{app_id}/installs.
+//
+// get all installs for an app
+func (c *Client) GetAppInstalls(ctx context.Context, appID string, params *GetAppInstallsParams) ([]AppInstall, error) {
+	var out []AppInstall
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/installs", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppLatestConfigParams holds the query parameters of GET /v1/apps/{app_id}/latest-config. Zero values are not sent.
+type GetAppLatestConfigParams struct {
+	// load all children configs
+	Recurse bool
+}
+
+func (p *GetAppLatestConfigParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Recurse {
+		q.Set("recurse", "true")
+	}
+	return q
+}
+
+// GetAppLatestConfig calls GET /v1/apps/{app_id}/latest-config.
+//
+// get latest app config
+func (c *Client) GetAppLatestConfig(ctx context.Context, appID string, params *GetAppLatestConfigParams) (*AppAppConfig, error) {
+	var out AppAppConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/latest-config", map[string]string{"app_id": appID}, params.values(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppOperationRoleConfigs calls GET /v1/apps/{app_id}/operation-role-configs/{operation_role_config_id}.
+//
+// get operation role configs
+func (c *Client) GetAppOperationRoleConfigs(ctx context.Context, appID string, operationRoleConfigID string) ([]AppAppOperationRoleConfig, error) {
+	var out []AppAppOperationRoleConfig
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/operation-role-configs/{operation_role_config_id}", map[string]string{"app_id": appID, "operation_role_config_id": operationRoleConfigID}, nil, nil, &out)
+	return out, err
+}
+
+// GetAppPermissionsConfig calls GET /v1/apps/{app_id}/permissions-configs/{config_id}.
+//
+// get app permissions config
+func (c *Client) GetAppPermissionsConfig(ctx context.Context, appID string, configID string) (*AppAppPermissionsConfig, error) {
+	var out AppAppPermissionsConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/permissions-configs/{config_id}", map[string]string{"app_id": appID, "config_id": configID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppPoliciesConfig calls GET /v1/apps/{app_id}/policies-configs/{config_id}.
+//
+// get app policies config
+func (c *Client) GetAppPoliciesConfig(ctx context.Context, appID string, configID string) (*AppAppPoliciesConfig, error) {
+	var out AppAppPoliciesConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/policies-configs/{config_id}", map[string]string{"app_id": appID, "config_id": configID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppPoliciesConfigsParams holds the query parameters of GET /v1/apps/{app_id}/policies-configs. Zero values are not sent.
+type GetAppPoliciesConfigsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppPoliciesConfigsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppPoliciesConfigs calls GET /v1/apps/{app_id}/policies-configs.
+//
+// get app policies configs
+func (c *Client) GetAppPoliciesConfigs(ctx context.Context, appID string, params *GetAppPoliciesConfigsParams) ([]AppAppPoliciesConfig, error) {
+	var out []AppAppPoliciesConfig
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/policies-configs", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppReleasesParams holds the query parameters of GET /v1/apps/{app_id}/releases. Zero values are not sent.
+type GetAppReleasesParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppReleasesParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppReleases calls GET /v1/apps/{app_id}/releases.
+//
+// get all releases for an app
+func (c *Client) GetAppReleases(ctx context.Context, appID string, params *GetAppReleasesParams) ([]AppComponentRelease, error) {
+	var out []AppComponentRelease
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/releases", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppRunnerConfigsParams holds the query parameters of GET /v1/apps/{app_id}/runner-configs. Zero values are not sent.
+type GetAppRunnerConfigsParams struct {
+	// offset of jobs to return
+	Offset int64
+	// limit of jobs to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppRunnerConfigsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppRunnerConfigs calls GET /v1/apps/{app_id}/runner-configs.
+//
+// get app runner configs
+func (c *Client) GetAppRunnerConfigs(ctx context.Context, appID string, params *GetAppRunnerConfigsParams) ([]AppAppRunnerConfig, error) {
+	var out []AppAppRunnerConfig
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/runner-configs", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppRunnerLatestConfig calls GET /v1/apps/{app_id}/runner-latest-config.
+//
+// get latest app runner config
+func (c *Client) GetAppRunnerLatestConfig(ctx context.Context, appID string) (*AppAppRunnerConfig, error) {
+	var out AppAppRunnerConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/runner-latest-config", map[string]string{"app_id": appID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppSandboxConfigsParams holds the query parameters of GET /v1/apps/{app_id}/sandbox-configs. Zero values are not sent.
+type GetAppSandboxConfigsParams struct {
+	// offset of jobs to return
+	Offset int64
+	// limit of jobs to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppSandboxConfigsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppSandboxConfigs calls GET /v1/apps/{app_id}/sandbox-configs.
+//
+// get app sandbox configs
+func (c *Client) GetAppSandboxConfigs(ctx context.Context, appID string, params *GetAppSandboxConfigsParams) ([]AppAppSandboxConfig, error) {
+	var out []AppAppSandboxConfig
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/sandbox-configs", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppSandboxLatestConfig calls GET /v1/apps/{app_id}/sandbox-latest-config.
+//
+// get latest app sandbox config
+func (c *Client) GetAppSandboxLatestConfig(ctx context.Context, appID string) (*AppAppSandboxConfig, error) {
+	var out AppAppSandboxConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/sandbox-latest-config", map[string]string{"app_id": appID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppSecretsParams holds the query parameters of GET /v1/apps/{app_id}/secrets. Zero values are not sent.
+type GetAppSecretsParams struct {
+	// offset of jobs to return
+	Offset int64
+	// limit of jobs to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppSecretsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetAppSecrets calls GET /v1/apps/{app_id}/secrets.
+//
+// get app secrets
+func (c *Client) GetAppSecrets(ctx context.Context, appID string, params *GetAppSecretsParams) ([]AppAppSecret, error) {
+	var out []AppAppSecret
+	err := c.do(ctx, "GET", "/v1/apps/{app_id}/secrets", map[string]string{"app_id": appID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAppSecretsConfig calls GET /v1/apps/{app_id}/secrets-configs/{config_id}.
+//
+// get app secrets config
+func (c *Client) GetAppSecretsConfig(ctx context.Context, appID string, configID string) (*AppAppSecretsConfig, error) {
+	var out AppAppSecretsConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/secrets-configs/{config_id}", map[string]string{"app_id": appID, "config_id": configID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppStackConfig calls GET /v1/apps/{app_id}/stack-configs/{config_id}.
+//
+// get app stack config
+func (c *Client) GetAppStackConfig(ctx context.Context, appID string, configID string) (*AppAppStackConfig, error) {
+	var out AppAppStackConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/stack-configs/{config_id}", map[string]string{"app_id": appID, "config_id": configID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppsParams holds the query parameters of GET /v1/apps. Zero values are not sent.
+type GetAppsParams struct {
+	// offset of jobs to return
+	Offset int64
+	// search query to filter apps by name
+	Q string
+	// limit of jobs to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetAppsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Q != "" {
+		q.Set("q", p.Q)
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetApps calls GET /v1/apps.
+//
+// get all apps for the current org
+func (c *Client) GetApps(ctx context.Context, params *GetAppsParams) ([]AppApp, error) {
+	var out []AppApp
+	err := c.do(ctx, "GET", "/v1/apps", nil, params.values(), nil, &out)
+	return out, err
+}
+
+// GetAuthMe calls GET /v1/auth/me.
+//
+// Get current account with identity information
+func (c *Client) GetAuthMe(ctx context.Context) (*ServiceAuthMeResponse, error) {
+	var out ServiceAuthMeResponse
+	if err := c.do(ctx, "GET", "/v1/auth/me", nil, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAvailableRolesParams holds the query parameters of GET /v1/installs/{install_id}/available-roles. Zero values are not sent.
+type GetAvailableRolesParams struct {
+	// principal type: component, sandbox, action
+	PrincipalType string
+	// operation type: provision, reprovision, deprovision, deploy, teardown, trigger
+	AppOperationType string
+}
+
+func (p *GetAvailableRolesParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.PrincipalType != "" {
+		q.Set("principal_type", p.PrincipalType)
+	}
+	if p.AppOperationType != "" {
+		q.Set("app.operationType", p.AppOperationType)
+	}
+	return q
+}
+
+// GetAvailableRoles calls GET /v1/installs/{install_id}/available-roles.
+//
+// get available IAM roles for a specific operation
+func (c *Client) GetAvailableRoles(ctx context.Context, installID string, params *GetAvailableRolesParams) (*ServiceAvailableRolesResponse, error) {
+	var out ServiceAvailableRolesResponse
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/available-roles", map[string]string{"install_id": installID}, params.values(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetBuild calls GET /v1/components/builds/{build_id}.
+//
+// get a build
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetBuild(ctx context.Context, buildID string) (*AppComponentBuild, error) {
+	var out AppComponentBuild
+	if err := c.do(ctx, "GET", "/v1/components/builds/{build_id}", map[string]string{"build_id": buildID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCLIConfig calls GET /v1/general/cli-config.
+//
+// Get config for cli
+func (c *Client) GetCLIConfig(ctx context.Context) (*ServiceCLIConfig, error) {
+	var out ServiceCLIConfig
+	if err := c.do(ctx, "GET", "/v1/general/cli-config", nil, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCloudPlatformRegions calls GET /v1/general/cloud-platform/{cloud_platform}/regions.
+//
+// Get regions for a cloud platform
+func (c *Client) GetCloudPlatformRegions(ctx context.Context, cloudPlatform string) ([]AppCloudPlatformRegion, error) {
+	var out []AppCloudPlatformRegion
+	err := c.do(ctx, "GET", "/v1/general/cloud-platform/{cloud_platform}/regions", map[string]string{"cloud_platform": cloudPlatform}, nil, nil, &out)
+	return out, err
+}
+
+// GetComponent calls GET /v1/components/{component_id}.
+//
+// get a component
+func (c *Client) GetComponent(ctx context.Context, componentID string) (*AppComponent, error) {
+	var out AppComponent
+	if err := c.do(ctx, "GET", "/v1/components/{component_id}", map[string]string{"component_id": componentID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetComponentBuild calls GET /v1/components/{component_id}/builds/{build_id}.
+//
+// get a build for a component
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetComponentBuild(ctx context.Context, componentID string, buildID string) (*AppComponentBuild, error) {
+	var out AppComponentBuild
+	if err := c.do(ctx, "GET", "/v1/components/{component_id}/builds/{build_id}", map[string]string{"component_id": componentID, "build_id": buildID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetComponentBuildsParams holds the query parameters of GET /v1/builds. Zero values are not sent.
+type GetComponentBuildsParams struct {
+	// component id to filter by
+	ComponentID string
+	// app id to filter by
+	AppID string
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetComponentBuildsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.ComponentID != "" {
+		q.Set("component_id", p.ComponentID)
+	}
+	if p.AppID != "" {
+		q.Set("app_id", p.AppID)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetComponentBuilds calls GET /v1/builds.
+//
+// get builds for components
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetComponentBuilds(ctx context.Context, params *GetComponentBuildsParams) ([]AppComponentBuild, error) {
+	var out []AppComponentBuild
+	err := c.do(ctx, "GET", "/v1/builds", nil, params.values(), nil, &out)
+	return out, err
+}
+
+// GetComponentConfig calls GET /v1/components/{component_id}/configs/{config_id}.
+//
+// get a config for a component
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetComponentConfig(ctx context.Context, componentID string, configID string) (*AppComponentConfigConnection, error) {
+	var out AppComponentConfigConnection
+	if err := c.do(ctx, "GET", "/v1/components/{component_id}/configs/{config_id}", map[string]string{"component_id": componentID, "config_id": configID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetComponentConfigsParams holds the query parameters of GET /v1/components/{component_id}/configs. Zero values are not sent.
+type GetComponentConfigsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetComponentConfigsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetComponentConfigs calls GET /v1/components/{component_id}/configs.
+//
+// get all configs for a component
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetComponentConfigs(ctx context.Context, componentID string, params *GetComponentConfigsParams) ([]AppComponentConfigConnection, error) {
+	var out []AppComponentConfigConnection
+	err := c.do(ctx, "GET", "/v1/components/{component_id}/configs", map[string]string{"component_id": componentID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetComponentDependencies calls GET /v1/components/{component_id}/dependencies.
+//
+// get a component's dependencies
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetComponentDependencies(ctx context.Context, componentID string) ([]AppComponent, error) {
+	var out []AppComponent
+	err := c.do(ctx, "GET", "/v1/components/{component_id}/dependencies", map[string]string{"component_id": componentID}, nil, nil, &out)
+	return out, err
+}
+
+// GetComponentDependents calls GET /v1/components/{component_id}/dependents.
+//
+// get a component's children
+func (c *Client) GetComponentDependents(ctx context.Context, componentID string) (*ServiceComponentChildren, error) {
+	var out ServiceComponentChildren
+	if err := c.do(ctx, "GET", "/v1/components/{component_id}/dependents", map[string]string{"component_id": componentID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetComponentLatestBuild calls GET /v1/components/{component_id}/builds/latest.
+//
+// get latest build for a component
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetComponentLatestBuild(ctx context.Context, componentID string) (*AppComponentBuild, error) {
+	var out AppComponentBuild
+	if err := c.do(ctx, "GET", "/v1/components/{component_id}/builds/latest", map[string]string{"component_id": componentID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetComponentLatestConfig calls GET /v1/components/{component_id}/configs/latest.
+//
+// get latest config for a component
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetComponentLatestConfig(ctx context.Context, componentID string) (*AppComponentConfigConnection, error) {
+	var out AppComponentConfigConnection
+	if err := c.do(ctx, "GET", "/v1/components/{component_id}/configs/latest", map[string]string{"component_id": componentID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetComponentReleasesParams holds the query parameters of GET /v1/components/{component_id}/releases. Zero values are not sent.
+type GetComponentReleasesParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetComponentReleasesParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetComponentReleases calls GET /v1/components/{component_id}/releases.
+//
+// get all releases for a component
+func (c *Client) GetComponentReleases(ctx context.Context, componentID string, params *GetComponentReleasesParams) ([]AppComponentRelease, error) {
+	var out []AppComponentRelease
+	err := c.do(ctx, "GET", "/v1/components/{component_id}/releases", map[string]string{"component_id": componentID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetConfigSchemaParams holds the query parameters of GET /v1/general/config-schema. Zero values are not sent.
+type GetConfigSchemaParams struct {
+	// return a schema for a source file
+	Type string
+}
+
+func (p *GetConfigSchemaParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Type != "" {
+		q.Set("type", p.Type)
+	}
+	return q
+}
+
+// GetConfigSchema calls GET /v1/general/config-schema.
+//
+// Get jsonschema for config file
+func (c *Client) GetConfigSchema(ctx context.Context, params *GetConfigSchemaParams) error {
+	return c.do(ctx, "GET", "/v1/general/config-schema", nil, params.values(), nil, nil)
+}
+
+// GetCurrentAccount calls GET /v1/account.
+//
+// Get current account
+func (c *Client) GetCurrentAccount(ctx context.Context) (*AppAccount, error) {
+	var out AppAccount
+	if err := c.do(ctx, "GET", "/v1/account", nil, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCurrentInstallInputs calls GET /v1/installs/{install_id}/inputs/current.
+//
+// get an installs current inputs
+func (c *Client) GetCurrentInstallInputs(ctx context.Context, installID string) (*AppInstallInputs, error) {
+	var out AppInstallInputs
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/inputs/current", map[string]string{"install_id": installID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCurrentOrgFeatures calls GET /v1/orgs/current/features.
+//
+// get current org's feature flags
+func (c *Client) GetCurrentOrgFeatures(ctx context.Context) (map[string]bool, error) {
+	var out map[string]bool
+	err := c.do(ctx, "GET", "/v1/orgs/current/features", nil, nil, nil, &out)
+	return out, err
+}
+
+// GetCurrentUser calls GET /v1/general/current-user.
+//
+// Get current user
+func (c *Client) GetCurrentUser(ctx context.Context) (*AppAccount, error) {
+	var out AppAccount
+	if err := c.do(ctx, "GET", "/v1/general/current-user", nil, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDriftedObjects calls GET /v1/installs/{install_id}/drifted-objects.
+//
+// get drifted objects for an install
+func (c *Client) GetDriftedObjects(ctx context.Context, installID string) ([]AppDriftedObject, error) {
+	var out []AppDriftedObject
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/drifted-objects", map[string]string{"install_id": installID}, nil, nil, &out)
+	return out, err
+}
+
+// GetInstallParams holds the query parameters of GET /v1/installs/{install_id}. Zero values are not sent.
+type GetInstallParams struct {
+	// whether to include drifted objects
+	IncludeDriftedObjects bool
+}
+
+func (p *GetInstallParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.IncludeDriftedObjects {
+		q.Set("include_drifted_objects", "true")
+	}
+	return q
+}
+
+// GetInstall calls GET /v1/installs/{install_id}.
+//
+// get an install
+func (c *Client) GetInstall(ctx context.Context, installID string, params *GetInstallParams) (*AppInstall, error) {
+	var out AppInstall
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}", map[string]string{"install_id": installID}, params.values(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallAction calls GET /v1/installs/{install_id}/actions/{action_id}.
+//
+// get an install action
+func (c *Client) GetInstallAction(ctx context.Context, installID string, actionID string) (*AppInstallActionWorkflow, error) {
+	var out AppInstallActionWorkflow
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/actions/{action_id}", map[string]string{"install_id": installID, "action_id": actionID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallActionRecentRunsParams holds the query parameters of GET /v1/installs/{install_id}/actions/{action_id}/recent-runs. Zero values are not sent.
+type GetInstallActionRecentRunsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallActionRecentRunsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallActionRecentRuns calls GET /v1/installs/{install_id}/actions/{action_id}/recent-runs.
+//
+// get recent runs for an action workflow by install id
+func (c *Client) GetInstallActionRecentRuns(ctx context.Context, installID string, actionID string, params *GetInstallActionRecentRunsParams) (*AppInstallActionWorkflow, error) {
+	var out AppInstallActionWorkflow
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/actions/{action_id}/recent-runs", map[string]string{"install_id": installID, "action_id": actionID}, params.values(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallActionRun calls GET /v1/installs/{install_id}/actions/runs/{run_id}.
+//
+// get action workflow runs by install id and run id
+func (c *Client) GetInstallActionRun(ctx context.Context, installID string, runID string) (*AppInstallActionWorkflowRun, error) {
+	var out AppInstallActionWorkflowRun
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/actions/runs/{run_id}", map[string]string{"install_id": installID, "run_id": runID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallActionRunStep calls GET /v1/installs/{install_id}/actions/runs/{run_id}/steps/{step_id}.
+//
+// get action workflow run step by install id and step id
+func (c *Client) GetInstallActionRunStep(ctx context.Context, installID string, runID string, stepID string) (*AppInstallActionWorkflowRunStep, error) {
+	var out AppInstallActionWorkflowRunStep
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/actions/runs/{run_id}/steps/{step_id}", map[string]string{"install_id": installID, "run_id": runID, "step_id": stepID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallActionRunsParams holds the query parameters of GET /v1/installs/{install_id}/actions/runs. Zero values are not sent.
+type GetInstallActionRunsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallActionRunsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallActionRuns calls GET /v1/installs/{install_id}/actions/runs.
+//
+// get action workflow runs by install id
+func (c *Client) GetInstallActionRuns(ctx context.Context, installID string, params *GetInstallActionRunsParams) ([]AppInstallActionWorkflowRun, error) {
+	var out []AppInstallActionWorkflowRun
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/actions/runs", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallActionWorkflow calls GET /v1/installs/{install_id}/action-workflows/{action_workflow_id}.
+//
+// get an install action workflow
+func (c *Client) GetInstallActionWorkflow(ctx context.Context, installID string, actionWorkflowID string) (*AppInstallActionWorkflow, error) {
+	var out AppInstallActionWorkflow
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/action-workflows/{action_workflow_id}", map[string]string{"install_id": installID, "action_workflow_id": actionWorkflowID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallActionWorkflowRecentRunsParams holds the query parameters of GET /v1/installs/{install_id}/action-workflows/{action_workflow_id}/recent-runs. Zero values are not sent.
+type GetInstallActionWorkflowRecentRunsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallActionWorkflowRecentRunsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallActionWorkflowRecentRuns calls GET /v1/installs/{install_id}/action-workflows/{action_workflow_id}/recent-runs.
+//
+// get recent runs for an action workflow by install id
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallActionWorkflowRecentRuns(ctx context.Context, installID string, actionWorkflowID string, params *GetInstallActionWorkflowRecentRunsParams) (*AppInstallActionWorkflow, error) {
+	var out AppInstallActionWorkflow
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/action-workflows/{action_workflow_id}/recent-runs", map[string]string{"install_id": installID, "action_workflow_id": actionWorkflowID}, params.values(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallActionWorkflowRun calls GET /v1/installs/{install_id}/action-workflows/runs/{run_id}.
+//
+// get action workflow runs by install id and run id
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallActionWorkflowRun(ctx context.Context, installID string, runID string) (*AppInstallActionWorkflowRun, error) {
+	var out AppInstallActionWorkflowRun
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/action-workflows/runs/{run_id}", map[string]string{"install_id": installID, "run_id": runID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallActionWorkflowRunStep calls GET /v1/installs/{install_id}/action-workflows/runs/{run_id}/steps/{step_id}.
+//
+// get action workflow run step by install id and step id
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallActionWorkflowRunStep(ctx context.Context, installID string, runID string, stepID string) (*AppInstallActionWorkflowRunStep, error) {
+	var out AppInstallActionWorkflowRunStep
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/action-workflows/runs/{run_id}/steps/{step_id}", map[string]string{"install_id": installID, "run_id": runID, "step_id": stepID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallActionWorkflowRunsParams holds the query parameters of GET /v1/installs/{install_id}/action-workflows/runs. Zero values are not sent.
+type GetInstallActionWorkflowRunsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallActionWorkflowRunsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallActionWorkflowRuns calls GET /v1/installs/{install_id}/action-workflows/runs.
+//
+// get action workflow runs by install id
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallActionWorkflowRuns(ctx context.Context, installID string, params *GetInstallActionWorkflowRunsParams) ([]AppInstallActionWorkflowRun, error) {
+	var out []AppInstallActionWorkflowRun
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/action-workflows/runs", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallActionWorkflowsParams holds the query parameters of GET /v1/installs/{install_id}/action-workflows. Zero values are not sent.
+type GetInstallActionWorkflowsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallActionWorkflowsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallActionWorkflows calls GET /v1/installs/{install_id}/action-workflows.
+//
+// get an installs action workflows
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallActionWorkflows(ctx context.Context, installID string, params *GetInstallActionWorkflowsParams) ([]AppInstallActionWorkflow, error) {
+	var out []AppInstallActionWorkflow
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/action-workflows", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallActionWorkflowsLatestRunsParams holds the query parameters of GET /v1/installs/{install_id}/action-workflows/latest-runs. Zero values are not sent.
+type GetInstallActionWorkflowsLatestRunsParams struct {
+	// filter by action workflow trigger by types
+	TriggerTypes string
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+	// search query for action workflow name
+	Q string
+}
+
+func (p *GetInstallActionWorkflowsLatestRunsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.TriggerTypes != "" {
+		q.Set("trigger_types", p.TriggerTypes)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Q != "" {
+		q.Set("q", p.Q)
+	}
+	return q
+}
+
+// GetInstallActionWorkflowsLatestRuns calls GET /v1/installs/{install_id}/action-workflows/latest-runs.
+//
+// get latest runs for all action workflows by install id
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallActionWorkflowsLatestRuns(ctx context.Context, installID string, params *GetInstallActionWorkflowsLatestRunsParams) ([]AppInstallActionWorkflow, error) {
+	var out []AppInstallActionWorkflow
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/action-workflows/latest-runs", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallActionsParams holds the query parameters of GET /v1/installs/{install_id}/actions. Zero values are not sent.
+type GetInstallActionsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallActionsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallActions calls GET /v1/installs/{install_id}/actions.
+//
+// get an installs action workflows
+func (c *Client) GetInstallActions(ctx context.Context, installID string, params *GetInstallActionsParams) ([]AppInstallActionWorkflow, error) {
+	var out []AppInstallActionWorkflow
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/actions", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallActionsLatestRunsParams holds the query parameters of GET /v1/installs/{install_id}/actions/latest-runs. Zero values are not sent.
+type GetInstallActionsLatestRunsParams struct {
+	// filter by action workflow trigger by types
+	TriggerTypes string
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+	// search query for action workflow name
+	Q string
+}
+
+func (p *GetInstallActionsLatestRunsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.TriggerTypes != "" {
+		q.Set("trigger_types", p.TriggerTypes)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Q != "" {
+		q.Set("q", p.Q)
+	}
+	return q
+}
+
+// GetInstallActionsLatestRuns calls GET /v1/installs/{install_id}/actions/latest-runs.
+//
+// get latest runs for all action workflows by install id
+func (c *Client) GetInstallActionsLatestRuns(ctx context.Context, installID string, params *GetInstallActionsLatestRunsParams) ([]AppInstallActionWorkflow, error) {
+	var out []AppInstallActionWorkflow
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/actions/latest-runs", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallAuditLogsParams holds the query parameters of GET /v1/installs/{install_id}/audit_logs. Zero values are not sent.
+type GetInstallAuditLogsParams struct {
+	// start timestamp for audit log range
+	Start string
+	// end timestamp for audit log range
+	End string
+}
+
+func (p *GetInstallAuditLogsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Start != "" {
+		q.Set("start", p.Start)
+	}
+	if p.End != "" {
+		q.Set("end", p.End)
+	}
+	return q
+}
+
+// GetInstallAuditLogs calls GET /v1/installs/{install_id}/audit_logs.
+//
+// get install audit logs
+func (c *Client) GetInstallAuditLogs(ctx context.Context, installID string, params *GetInstallAuditLogsParams) ([]byte, error) {
+	var out []byte
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/audit_logs", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallComponent calls GET /v1/installs/{install_id}/components/{component_id}.
+//
+// get an install component
+func (c *Client) GetInstallComponent(ctx context.Context, installID string, componentID string) (*AppInstallComponent, error) {
+	var out AppInstallComponent
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/components/{component_id}", map[string]string{"install_id": installID, "component_id": componentID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallComponentDeploy calls GET /v1/installs/{install_id}/components/{component_id}/deploys/{deploy_id}.
+//
+// get an install deploy
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallComponentDeploy(ctx context.Context, installID string, componentID string, deployID string) (*AppInstallDeploy, error) {
+	var out AppInstallDeploy
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/components/{component_id}/deploys/{deploy_id}", map[string]string{"install_id": installID, "component_id": componentID, "deploy_id": deployID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallComponentDeploysParams holds the query parameters of GET /v1/installs/{install_id}/components/{component_id}/deploys. Zero values are not sent.
+type GetInstallComponentDeploysParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallComponentDeploysParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallComponentDeploys calls GET /v1/installs/{install_id}/components/{component_id}/deploys.
+//
+// get an install components deploys
+func (c *Client) GetInstallComponentDeploys(ctx context.Context, installID string, componentID string, params *GetInstallComponentDeploysParams) ([]AppInstallDeploy, error) {
+	var out []AppInstallDeploy
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/components/{component_id}/deploys", map[string]string{"install_id": installID, "component_id": componentID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallComponentLatestDeploy calls GET /v1/installs/{install_id}/components/{component_id}/deploys/latest.
+//
+// get the latest deploy for an install component
+func (c *Client) GetInstallComponentLatestDeploy(ctx context.Context, installID string, componentID string) (*AppInstallDeploy, error) {
+	var out AppInstallDeploy
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/components/{component_id}/deploys/latest", map[string]string{"install_id": installID, "component_id": componentID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallComponentOutputs calls GET /v1/installs/{install_id}/components/{component_id}/outputs.
+//
+// get an install component outputs
+func (c *Client) GetInstallComponentOutputs(ctx context.Context, installID string, componentID string) (map[string]any, error) {
+	var out map[string]any
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/components/{component_id}/outputs", map[string]string{"install_id": installID, "component_id": componentID}, nil, nil, &out)
+	return out, err
+}
+
+// GetInstallComponentsParams holds the query parameters of GET /v1/installs/{install_id}/components. Zero values are not sent.
+type GetInstallComponentsParams struct {
+	// component types to filter by
+	Types string
+	// search query for component name
+	Q string
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+}
+
+func (p *GetInstallComponentsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Types != "" {
+		q.Set("types", p.Types)
+	}
+	if p.Q != "" {
+		q.Set("q", p.Q)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	return q
+}
+
+// GetInstallComponents calls GET /v1/installs/{install_id}/components.
+//
+// get an installs components
+func (c *Client) GetInstallComponents(ctx context.Context, installID string, params *GetInstallComponentsParams) ([]AppInstallComponent, error) {
+	var out []AppInstallComponent
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/components", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallComponentsDeploysParams holds the query parameters of GET /v1/installs/{install_id}/components/deploys. Zero values are not sent.
+type GetInstallComponentsDeploysParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallComponentsDeploysParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallComponentsDeploys calls GET /v1/installs/{install_id}/components/deploys.
+//
+// get all deploys to an install
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallComponentsDeploys(ctx context.Context, installID string, params *GetInstallComponentsDeploysParams) ([]AppInstallDeploy, error) {
+	var out []AppInstallDeploy
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/components/deploys", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallDeploy calls GET /v1/installs/{install_id}/deploys/{deploy_id}.
+//
+// get an install deploy
+func (c *Client) GetInstallDeploy(ctx context.Context, installID string, deployID string) (*AppInstallDeploy, error) {
+	var out AppInstallDeploy
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/deploys/{deploy_id}", map[string]string{"install_id": installID, "deploy_id": deployID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallDeploysParams holds the query parameters of GET /v1/installs/{install_id}/deploys. Zero values are not sent.
+type GetInstallDeploysParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallDeploysParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallDeploys calls GET /v1/installs/{install_id}/deploys.
+//
+// get all deploys to an install
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallDeploys(ctx context.Context, installID string, params *GetInstallDeploysParams) ([]AppInstallDeploy, error) {
+	var out []AppInstallDeploy
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/deploys", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallEvent calls GET /v1/installs/{install_id}/events/{event_id}.
+//
+// get an install event
+func (c *Client) GetInstallEvent(ctx context.Context, installID string, eventID string) (*AppInstallEvent, error) {
+	var out AppInstallEvent
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/events/{event_id}", map[string]string{"install_id": installID, "event_id": eventID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallEventsParams holds the query parameters of GET /v1/installs/{install_id}/events. Zero values are not sent.
+type GetInstallEventsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallEventsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallEvents calls GET /v1/installs/{install_id}/events.
+//
+// get events for an install
+func (c *Client) GetInstallEvents(ctx context.Context, installID string, params *GetInstallEventsParams) ([]AppInstallEvent, error) {
+	var out []AppInstallEvent
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/events", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallInputsParams holds the query parameters of GET /v1/installs/{install_id}/inputs. Zero values are not sent.
+type GetInstallInputsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallInputsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallInputs calls GET /v1/installs/{install_id}/inputs.
+//
+// get an installs inputs
+func (c *Client) GetInstallInputs(ctx context.Context, installID string, params *GetInstallInputsParams) ([]AppInstallInputs, error) {
+	var out []AppInstallInputs
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/inputs", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallLatestDeploy calls GET /v1/installs/{install_id}/deploys/latest.
+//
+// get an install's latest deploy
+func (c *Client) GetInstallLatestDeploy(ctx context.Context, installID string) (*AppInstallDeploy, error) {
+	var out AppInstallDeploy
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/deploys/latest", map[string]string{"install_id": installID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallReadme calls GET /v1/installs/{install_id}/readme.
+//
+// get install readme rendered with
+func (c *Client) GetInstallReadme(ctx context.Context, installID string) (*ServiceReadme, error) {
+	var out ServiceReadme
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/readme", map[string]string{"install_id": installID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallRunnerGroup calls GET /v1/installs/{install_id}/runner-group.
+//
+// Get an install's runner group
+func (c *Client) GetInstallRunnerGroup(ctx context.Context, installID string) (*AppRunnerGroup, error) {
+	var out AppRunnerGroup
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/runner-group", map[string]string{"install_id": installID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallSandboxRun calls GET /v1/installs/sandbox-runs/{run_id}.
+//
+// get an install sandbox run
+func (c *Client) GetInstallSandboxRun(ctx context.Context, runID string) (*AppInstallSandboxRun, error) {
+	var out AppInstallSandboxRun
+	if err := c.do(ctx, "GET", "/v1/installs/sandbox-runs/{run_id}", map[string]string{"run_id": runID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallSandboxRunV2 calls GET /v1/installs/{install_id}/sandbox-runs/{run_id}.
+//
+// get an install sandbox run
+func (c *Client) GetInstallSandboxRunV2(ctx context.Context, installID string, runID string) (*AppInstallSandboxRun, error) {
+	var out AppInstallSandboxRun
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/sandbox-runs/{run_id}", map[string]string{"install_id": installID, "run_id": runID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallSandboxRunsParams holds the query parameters of GET /v1/installs/{install_id}/sandbox-runs. Zero values are not sent.
+type GetInstallSandboxRunsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallSandboxRunsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallSandboxRuns calls GET /v1/installs/{install_id}/sandbox-runs.
+//
+// get an installs sandbox runs
+func (c *Client) GetInstallSandboxRuns(ctx context.Context, installID string, params *GetInstallSandboxRunsParams) ([]AppInstallSandboxRun, error) {
+	var out []AppInstallSandboxRun
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/sandbox-runs", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallStack calls GET /v1/installs/stacks/{stack_id}.
+//
+// get an install stack by stack ID
+func (c *Client) GetInstallStack(ctx context.Context, stackID string) (*AppInstallStack, error) {
+	var out AppInstallStack
+	if err := c.do(ctx, "GET", "/v1/installs/stacks/{stack_id}", map[string]string{"stack_id": stackID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallStackByInstallID calls GET /v1/installs/{install_id}/stack.
+//
+// get an install stack by install ID
+func (c *Client) GetInstallStackByInstallID(ctx context.Context, installID string) (*AppInstallStack, error) {
+	var out AppInstallStack
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/stack", map[string]string{"install_id": installID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallStackRuns calls GET /v1/installs/{install_id}/stack-runs.
+//
+// get an install's stack runs
+func (c *Client) GetInstallStackRuns(ctx context.Context, installID string) (*AppInstallStackVersionRun, error) {
+	var out AppInstallStackVersionRun
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/stack-runs", map[string]string{"install_id": installID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallState calls GET /v1/installs/{install_id}/state.
+//
+// Get the current state of an install.
+func (c *Client) GetInstallState(ctx context.Context, installID string) (*TypesStateState, error) {
+	var out TypesStateState
+	if err := c.do(ctx, "GET", "/v1/installs/{install_id}/state", map[string]string{"install_id": installID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallStateHistoryParams holds the query parameters of GET /v1/installs/{install_id}/state-history. Zero values are not sent.
+type GetInstallStateHistoryParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetInstallStateHistoryParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetInstallStateHistory calls GET /v1/installs/{install_id}/state-history.
+//
+// Get install state history.
+func (c *Client) GetInstallStateHistory(ctx context.Context, installID string, params *GetInstallStateHistoryParams) ([]AppInstallState, error) {
+	var out []AppInstallState
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/state-history", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetInstallWorkflow calls GET /v1/install-workflows/{install_workflow_id}.
+//
+// get an install workflow
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallWorkflow(ctx context.Context, installWorkflowID string) (*AppWorkflow, error) {
+	var out AppWorkflow
+	if err := c.do(ctx, "GET", "/v1/install-workflows/{install_workflow_id}", map[string]string{"install_workflow_id": installWorkflowID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallWorkflowStep calls GET /v1/install-workflows/{install_workflow_id}/steps/{install_workflow_step_id}.
+//
+// get an install workflow step
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallWorkflowStep(ctx context.Context, installWorkflowID string, installWorkflowStepID string) (*AppWorkflowStep, error) {
+	var out AppWorkflowStep
+	if err := c.do(ctx, "GET", "/v1/install-workflows/{install_workflow_id}/steps/{install_workflow_step_id}", map[string]string{"install_workflow_id": installWorkflowID, "install_workflow_step_id": installWorkflowStepID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallWorkflowStepApproval calls GET /v1/install-workflows/{install_workflow_id}/steps/{install_workflow_step_id}/approvals/{approval_id}.
+//
+// get an install workflow step approval
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallWorkflowStepApproval(ctx context.Context, installWorkflowID string, installWorkflowStepID string, approvalID string) (*AppWorkflowStepApproval, error) {
+	var out AppWorkflowStepApproval
+	if err := c.do(ctx, "GET", "/v1/install-workflows/{install_workflow_id}/steps/{install_workflow_step_id}/approvals/{approval_id}", map[string]string{"install_workflow_id": installWorkflowID, "install_workflow_step_id": installWorkflowStepID, "approval_id": approvalID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInstallWorkflowSteps calls GET /v1/install-workflows/{install_workflow_id}/steps.
+//
+// get all of the steps for a given install workflow
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetInstallWorkflowSteps(ctx context.Context, installWorkflowID string) ([]AppWorkflowStep, error) {
+	var out []AppWorkflowStep
+	err := c.do(ctx, "GET", "/v1/install-workflows/{install_workflow_id}/steps", map[string]string{"install_workflow_id": installWorkflowID}, nil, nil, &out)
+	return out, err
+}
+
+// GetLatestAppBreakGlassConfig calls GET /v1/apps/{app_id}/latest-break-glass-config.
+//
+// get latest app break glass config
+func (c *Client) GetLatestAppBreakGlassConfig(ctx context.Context, appID string) (*AppAppBreakGlassConfig, error) {
+	var out AppAppBreakGlassConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/latest-break-glass-config", map[string]string{"app_id": appID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetLatestAppPermissionsConfig calls GET /v1/apps/{app_id}/latest-permissions-config.
+//
+// get latest app permissions config
+func (c *Client) GetLatestAppPermissionsConfig(ctx context.Context, appID string) (*AppAppPermissionsConfig, error) {
+	var out AppAppPermissionsConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/latest-permissions-config", map[string]string{"app_id": appID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetLatestAppPoliciesConfig calls GET /v1/apps/{app_id}/latest-policies-config.
+//
+// get latest app policies config
+func (c *Client) GetLatestAppPoliciesConfig(ctx context.Context, appID string) (*AppAppPoliciesConfig, error) {
+	var out AppAppPoliciesConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/latest-policies-config", map[string]string{"app_id": appID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetLatestAppSecretsConfig calls GET /v1/apps/{app_id}/latest-secrets-config.
+//
+// get latest app secrets config
+func (c *Client) GetLatestAppSecretsConfig(ctx context.Context, appID string) (*AppAppSecretsConfig, error) {
+	var out AppAppSecretsConfig
+	if err := c.do(ctx, "GET", "/v1/apps/{app_id}/latest-secrets-config", map[string]string{"app_id": appID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetLatestRunnerHeartBeat calls GET /v1/runners/{runner_id}/heart-beats/latest.
+//
+// get a runner
+func (c *Client) GetLatestRunnerHeartBeat(ctx context.Context, runnerID string) (*ServiceLatestRunnerHeartBeats, error) {
+	var out ServiceLatestRunnerHeartBeats
+	if err := c.do(ctx, "GET", "/v1/runners/{runner_id}/heart-beats/latest", map[string]string{"runner_id": runnerID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetLogStream calls GET /v1/log-streams/{log_stream_id}.
+//
+// get a log stream
+func (c *Client) GetLogStream(ctx context.Context, logStreamID string) (*AppLogStream, error) {
+	var out AppLogStream
+	if err := c.do(ctx, "GET", "/v1/log-streams/{log_stream_id}", map[string]string{"log_stream_id": logStreamID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOrg calls GET /v1/orgs/current.
+//
+// Get an org
+func (c *Client) GetOrg(ctx context.Context) (*AppOrg, error) {
+	var out AppOrg
+	if err := c.do(ctx, "GET", "/v1/orgs/current", nil, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOrgAcountsParams holds the query parameters of GET /v1/orgs/current/accounts. Zero values are not sent.
+type GetOrgAcountsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetOrgAcountsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetOrgAcounts calls GET /v1/orgs/current/accounts.
+//
+// Get user accounts for current org
+func (c *Client) GetOrgAcounts(ctx context.Context, params *GetOrgAcountsParams) (*AppAccount, error) {
+	var out AppAccount
+	if err := c.do(ctx, "GET", "/v1/orgs/current/accounts", nil, params.values(), nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOrgComponentsParams holds the query parameters of GET /v1/components. Zero values are not sent.
+type GetOrgComponentsParams struct {
+	// comma-separated list of component IDs to filter by
+	ComponentIDs string
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetOrgComponentsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.ComponentIDs != "" {
+		q.Set("component_ids", p.ComponentIDs)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetOrgComponents calls GET /v1/components.
+//
+// get all components for an org
+func (c *Client) GetOrgComponents(ctx context.Context, params *GetOrgComponentsParams) ([]AppComponent, error) {
+	var out []AppComponent
+	err := c.do(ctx, "GET", "/v1/components", nil, params.values(), nil, &out)
+	return out, err
+}
+
+// GetOrgFeatures calls GET /v1/orgs/features.
+//
+// get available org features
+func (c *Client) GetOrgFeatures(ctx context.Context) ([]AppOrgFeatureInfo, error) {
+	var out []AppOrgFeatureInfo
+	err := c.do(ctx, "GET", "/v1/orgs/features", nil, nil, nil, &out)
+	return out, err
+}
+
+// GetOrgInstallsParams holds the query parameters of GET /v1/installs. Zero values are not sent.
+type GetOrgInstallsParams struct {
+	// offset of results to return
+	Offset int64
+	// search query to filter installs by name
+	Q string
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetOrgInstallsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Q != "" {
+		q.Set("q", p.Q)
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetOrgInstalls calls GET /v1/installs.
+//
+// get all installs for an org
+func (c *Client) GetOrgInstalls(ctx context.Context, params *GetOrgInstallsParams) ([]AppInstall, error) {
+	var out []AppInstall
+	err := c.do(ctx, "GET", "/v1/installs", nil, params.values(), nil, &out)
+	return out, err
+}
+
+// GetOrgInvitesParams holds the query parameters of GET /v1/orgs/current/invites. Zero values are not sent.
+type GetOrgInvitesParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetOrgInvitesParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetOrgInvites calls GET /v1/orgs/current/invites.
+//
+// Return org invites
+func (c *Client) GetOrgInvites(ctx context.Context, params *GetOrgInvitesParams) ([]AppOrgInvite, error) {
+	var out []AppOrgInvite
+	err := c.do(ctx, "GET", "/v1/orgs/current/invites", nil, params.values(), nil, &out)
+	return out, err
+}
+
+// GetOrgRunnerGroup calls GET /v1/orgs/current/runner-group.
+//
+// Get an org's runner group
+func (c *Client) GetOrgRunnerGroup(ctx context.Context) (*AppRunnerGroup, error) {
+	var out AppRunnerGroup
+	if err := c.do(ctx, "GET", "/v1/orgs/current/runner-group", nil, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOrgStats calls GET /v1/orgs/current/stats.
+//
+// Get an org
+func (c *Client) GetOrgStats(ctx context.Context) (*AppOrg, error) {
+	var out AppOrg
+	if err := c.do(ctx, "GET", "/v1/orgs/current/stats", nil, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOrgVCSConnectionsParams holds the query parameters of GET /v1/vcs/connections. Zero values are not sent.
+type GetOrgVCSConnectionsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetOrgVCSConnectionsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetOrgVCSConnections calls GET /v1/vcs/connections.
+//
+// get vcs connection for an org
+func (c *Client) GetOrgVCSConnections(ctx context.Context, params *GetOrgVCSConnectionsParams) ([]AppVCSConnection, error) {
+	var out []AppVCSConnection
+	err := c.do(ctx, "GET", "/v1/vcs/connections", nil, params.values(), nil, &out)
+	return out, err
+}
+
+// GetOrgsParams holds the query parameters of GET /v1/orgs. Zero values are not sent.
+type GetOrgsParams struct {
+	// search query
+	Q string
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetOrgsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Q != "" {
+		q.Set("q", p.Q)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetOrgs calls GET /v1/orgs.
+//
+// Return current user's orgs
+func (c *Client) GetOrgs(ctx context.Context, params *GetOrgsParams) ([]AppOrg, error) {
+	var out []AppOrg
+	err := c.do(ctx, "GET", "/v1/orgs", nil, params.values(), nil, &out)
+	return out, err
+}
+
+// GetRelease calls GET /v1/releases/{release_id}.
+//
+// get a release
+func (c *Client) GetRelease(ctx context.Context, releaseID string) (*AppComponentRelease, error) {
+	var out AppComponentRelease
+	if err := c.do(ctx, "GET", "/v1/releases/{release_id}", map[string]string{"release_id": releaseID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetReleaseStepsParams holds the query parameters of GET /v1/releases/{release_id}/steps. Zero values are not sent.
+type GetReleaseStepsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetReleaseStepsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetReleaseSteps calls GET /v1/releases/{release_id}/steps.
+//
+// get a release's steps
+func (c *Client) GetReleaseSteps(ctx context.Context, releaseID string, params *GetReleaseStepsParams) ([]AppComponentReleaseStep, error) {
+	var out []AppComponentReleaseStep
+	err := c.do(ctx, "GET", "/v1/releases/{release_id}/steps", map[string]string{"release_id": releaseID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetRunnerCardDetails calls GET /v1/runners/{runner_id}/card-details.
+//
+// get runner card details
+func (c *Client) GetRunnerCardDetails(ctx context.Context, runnerID string) (*ServiceRunnerCardDetailsResponse, error) {
+	var out ServiceRunnerCardDetailsResponse
+	if err := c.do(ctx, "GET", "/v1/runners/{runner_id}/card-details", map[string]string{"runner_id": runnerID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRunnerConnectStatus calls GET /v1/runners/{runner_id}/connected.
+//
+// get a runner connection satus based on heartbeat
+func (c *Client) GetRunnerConnectStatus(ctx context.Context, runnerID string) (*ServiceRunnerConnectionStatus, error) {
+	var out ServiceRunnerConnectionStatus
+	if err := c.do(ctx, "GET", "/v1/runners/{runner_id}/connected", map[string]string{"runner_id": runnerID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRunnerJob calls GET /v1/runner-jobs/{runner_job_id}.
+//
+// get runner job
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetRunnerJob(ctx context.Context, runnerJobID string) (*AppRunnerJob, error) {
+	var out AppRunnerJob
+	if err := c.do(ctx, "GET", "/v1/runner-jobs/{runner_job_id}", map[string]string{"runner_job_id": runnerJobID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRunnerJobCompositePlan calls GET /v1/runner-jobs/{runner_job_id}/composite-plan.
+//
+// get runner job composite plan
+func (c *Client) GetRunnerJobCompositePlan(ctx context.Context, runnerJobID string) (*PlantypesCompositePlan, error) {
+	var out PlantypesCompositePlan
+	if err := c.do(ctx, "GET", "/v1/runner-jobs/{runner_job_id}/composite-plan", map[string]string{"runner_job_id": runnerJobID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRunnerJobPlan calls GET /v1/runner-jobs/{runner_job_id}/plan.
+//
+// get runner job plan
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetRunnerJobPlan(ctx context.Context, runnerJobID string) (string, error) {
+	var out string
+	err := c.do(ctx, "GET", "/v1/runner-jobs/{runner_job_id}/plan", map[string]string{"runner_job_id": runnerJobID}, nil, nil, &out)
+	return out, err
+}
+
+// GetRunnerJobsParams holds the query parameters of GET /v1/runners/{runner_id}/jobs. Zero values are not sent.
+type GetRunnerJobsParams struct {
+	// job group
+	Group string
+	// job groups
+	Groups string
+	// job status
+	Status string
+	// job statuses
+	Statuses string
+	// offset of jobs to return
+	Offset int64
+	// limit of jobs to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetRunnerJobsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Group != "" {
+		q.Set("group", p.Group)
+	}
+	if p.Groups != "" {
+		q.Set("groups", p.Groups)
+	}
+	if p.Status != "" {
+		q.Set("status", p.Status)
+	}
+	if p.Statuses != "" {
+		q.Set("statuses", p.Statuses)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetRunnerJobs calls GET /v1/runners/{runner_id}/jobs.
+//
+// get runner jobs
+func (c *Client) GetRunnerJobs(ctx context.Context, runnerID string, params *GetRunnerJobsParams) ([]AppRunnerJob, error) {
+	var out []AppRunnerJob
+	err := c.do(ctx, "GET", "/v1/runners/{runner_id}/jobs", map[string]string{"runner_id": runnerID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetRunnerLatestHeartBeat calls GET /v1/runners/{runner_id}/latest-heart-beat.
+//
+// get the latest heartbeats for a runner
+func (c *Client) GetRunnerLatestHeartBeat(ctx context.Context, runnerID string) (*AppRunnerHeartBeat, error) {
+	var out AppRunnerHeartBeat
+	if err := c.do(ctx, "GET", "/v1/runners/{runner_id}/latest-heart-beat", map[string]string{"runner_id": runnerID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRunnerRecentHealthChecksParams holds the query parameters of GET /v1/runners/{runner_id}/recent-health-checks. Zero values are not sent.
+type GetRunnerRecentHealthChecksParams struct {
+	// window of health checks to return
+	Window string
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+}
+
+func (p *GetRunnerRecentHealthChecksParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Window != "" {
+		q.Set("window", p.Window)
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	return q
+}
+
+// GetRunnerRecentHealthChecks calls GET /v1/runners/{runner_id}/recent-health-checks.
+//
+// get recent health checks
+func (c *Client) GetRunnerRecentHealthChecks(ctx context.Context, runnerID string, params *GetRunnerRecentHealthChecksParams) ([]AppRunnerHealthCheck, error) {
+	var out []AppRunnerHealthCheck
+	err := c.do(ctx, "GET", "/v1/runners/{runner_id}/recent-health-checks", map[string]string{"runner_id": runnerID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetRunnerSettings calls GET /v1/runners/{runner_id}/settings.
+//
+// get runner settings
+func (c *Client) GetRunnerSettings(ctx context.Context, runnerID string) (*AppRunnerGroupSettings, error) {
+	var out AppRunnerGroupSettings
+	if err := c.do(ctx, "GET", "/v1/runners/{runner_id}/settings", map[string]string{"runner_id": runnerID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTerraformCurrentStateData calls GET /v1/terraform-backend.
+//
+// get current terraform
+func (c *Client) GetTerraformCurrentStateData(ctx context.Context) (*AppTerraformWorkspaceState, error) {
+	var out AppTerraformWorkspaceState
+	if err := c.do(ctx, "GET", "/v1/terraform-backend", nil, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTerraformStatesParams holds the query parameters of GET /v1/runners/terraform-workspace/{workspace_id}/states. Zero values are not sent.
+type GetTerraformStatesParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetTerraformStatesParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetTerraformStates calls GET /v1/runners/terraform-workspace/{workspace_id}/states.
+//
+// get terraform states
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetTerraformStates(ctx context.Context, workspaceID string, params *GetTerraformStatesParams) ([]AppTerraformWorkspaceState, error) {
+	var out []AppTerraformWorkspaceState
+	err := c.do(ctx, "GET", "/v1/runners/terraform-workspace/{workspace_id}/states", map[string]string{"workspace_id": workspaceID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetTerraformStatesV2Params holds the query parameters of GET /v1/terraform-workspaces/{workspace_id}/states. Zero values are not sent.
+type GetTerraformStatesV2Params struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetTerraformStatesV2Params) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetTerraformStatesV2 calls GET /v1/terraform-workspaces/{workspace_id}/states.
+//
+// get terraform states
+func (c *Client) GetTerraformStatesV2(ctx context.Context, workspaceID string, params *GetTerraformStatesV2Params) ([]AppTerraformWorkspaceState, error) {
+	var out []AppTerraformWorkspaceState
+	err := c.do(ctx, "GET", "/v1/terraform-workspaces/{workspace_id}/states", map[string]string{"workspace_id": workspaceID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetTerraformWorkspace calls GET /v1/terraform-workspaces/{workspace_id}.
+//
+// get  terraform workspace
+func (c *Client) GetTerraformWorkspace(ctx context.Context, workspaceID string) ([]AppTerraformWorkspace, error) {
+	var out []AppTerraformWorkspace
+	err := c.do(ctx, "GET", "/v1/terraform-workspaces/{workspace_id}", map[string]string{"workspace_id": workspaceID}, nil, nil, &out)
+	return out, err
+}
+
+// GetTerraformWorkspaceLock calls GET /v1/terraform-workspaces/{workspace_id}/lock.
+//
+// get terraform workspace lock
+func (c *Client) GetTerraformWorkspaceLock(ctx context.Context, workspaceID string) (*AppTerraformWorkspaceLock, error) {
+	var out AppTerraformWorkspaceLock
+	if err := c.do(ctx, "GET", "/v1/terraform-workspaces/{workspace_id}/lock", map[string]string{"workspace_id": workspaceID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTerraformWorkspaceStateByID calls GET /v1/runners/terraform-workspace/{workspace_id}/states/{state_id}.
+//
+// get terraform state by ID
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetTerraformWorkspaceStateByID(ctx context.Context, workspaceID string, stateID string) (*AppTerraformWorkspaceState, error) {
+	var out AppTerraformWorkspaceState
+	if err := c.do(ctx, "GET", "/v1/runners/terraform-workspace/{workspace_id}/states/{state_id}", map[string]string{"workspace_id": workspaceID, "state_id": stateID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTerraformWorkspaceStateByIDV2 calls GET /v1/terraform-workspaces/{workspace_id}/states/{state_id}.
+//
+// get terraform state by ID
+func (c *Client) GetTerraformWorkspaceStateByIDV2(ctx context.Context, workspaceID string, stateID string) (*AppTerraformWorkspaceState, error) {
+	var out AppTerraformWorkspaceState
+	if err := c.do(ctx, "GET", "/v1/terraform-workspaces/{workspace_id}/states/{state_id}", map[string]string{"workspace_id": workspaceID, "state_id": stateID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTerraformWorkspaceStateJSONResources calls GET /v1/runners/terraform-workspace/{workspace_id}/state-json/{state_id}/resources.
+//
+// get terraform state resources. This output is similar to "terraform state list"
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetTerraformWorkspaceStateJSONResources(ctx context.Context, workspaceID string, stateID string) error {
+	return c.do(ctx, "GET", "/v1/runners/terraform-workspace/{workspace_id}/state-json/{state_id}/resources", map[string]string{"workspace_id": workspaceID, "state_id": stateID}, nil, nil, nil)
+}
+
+// GetTerraformWorkspaceStateJSONResourcesV2 calls GET /v1/terraform-workspaces/{workspace_id}/state-json/{state_id}/resources.
+//
+// get terraform state resources. This output is similar to "terraform state list"
+func (c *Client) GetTerraformWorkspaceStateJSONResourcesV2(ctx context.Context, workspaceID string, stateID string) error {
+	return c.do(ctx, "GET", "/v1/terraform-workspaces/{workspace_id}/state-json/{state_id}/resources", map[string]string{"workspace_id": workspaceID, "state_id": stateID}, nil, nil, nil)
+}
+
+// GetTerraformWorkspaceStateResources calls GET /v1/runners/terraform-workspace/{workspace_id}/states/{state_id}/resources.
+//
+// get terraform state resources
+func (c *Client) GetTerraformWorkspaceStateResources(ctx context.Context, workspaceID string, stateID string) ([]AppTerraformStateResource, error) {
+	var out []AppTerraformStateResource
+	err := c.do(ctx, "GET", "/v1/runners/terraform-workspace/{workspace_id}/states/{state_id}/resources", map[string]string{"workspace_id": workspaceID, "state_id": stateID}, nil, nil, &out)
+	return out, err
+}
+
+// GetTerraformWorkspaceStatesJSONParams holds the query parameters of GET /v1/runners/terraform-workspace/{workspace_id}/state-json. Zero values are not sent.
+type GetTerraformWorkspaceStatesJSONParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetTerraformWorkspaceStatesJSONParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetTerraformWorkspaceStatesJSON calls GET /v1/runners/terraform-workspace/{workspace_id}/state-json.
+//
+// get terraform states json
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetTerraformWorkspaceStatesJSON(ctx context.Context, workspaceID string, params *GetTerraformWorkspaceStatesJSONParams) ([]AppTerraformWorkspaceStateJSON, error) {
+	var out []AppTerraformWorkspaceStateJSON
+	err := c.do(ctx, "GET", "/v1/runners/terraform-workspace/{workspace_id}/state-json", map[string]string{"workspace_id": workspaceID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetTerraformWorkspaceStatesJSONByID calls GET /v1/runners/terraform-workspace/{workspace_id}/state-json/{state_id}.
+//
+// get terraform state json by id. This output is same as "terraform show --json"
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) GetTerraformWorkspaceStatesJSONByID(ctx context.Context, workspaceID string, stateID string) (map[string]any, error) {
+	var out map[string]any
+	err := c.do(ctx, "GET", "/v1/runners/terraform-workspace/{workspace_id}/state-json/{state_id}", map[string]string{"workspace_id": workspaceID, "state_id": stateID}, nil, nil, &out)
+	return out, err
+}
+
+// GetTerraformWorkspaceStatesJSONByIDV2 calls GET /v1/terraform-workspaces/{workspace_id}/state-json/{state_id}.
+//
+// get terraform state json by id. This output is same as "terraform show --json"
+func (c *Client) GetTerraformWorkspaceStatesJSONByIDV2(ctx context.Context, workspaceID string, stateID string) (map[string]any, error) {
+	var out map[string]any
+	err := c.do(ctx, "GET", "/v1/terraform-workspaces/{workspace_id}/state-json/{state_id}", map[string]string{"workspace_id": workspaceID, "state_id": stateID}, nil, nil, &out)
+	return out, err
+}
+
+// GetTerraformWorkspaceStatesJSONV2Params holds the query parameters of GET /v1/terraform-workspaces/{workspace_id}/state-json. Zero values are not sent.
+type GetTerraformWorkspaceStatesJSONV2Params struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+}
+
+func (p *GetTerraformWorkspaceStatesJSONV2Params) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	return q
+}
+
+// GetTerraformWorkspaceStatesJSONV2 calls GET /v1/terraform-workspaces/{workspace_id}/state-json.
+//
+// get terraform states json
+func (c *Client) GetTerraformWorkspaceStatesJSONV2(ctx context.Context, workspaceID string, params *GetTerraformWorkspaceStatesJSONV2Params) ([]AppTerraformWorkspaceStateJSON, error) {
+	var out []AppTerraformWorkspaceStateJSON
+	err := c.do(ctx, "GET", "/v1/terraform-workspaces/{workspace_id}/state-json", map[string]string{"workspace_id": workspaceID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GetTerraformWorkspaces calls GET /v1/terraform-workspaces.
+//
+// get  terraform workspaces
+func (c *Client) GetTerraformWorkspaces(ctx context.Context) ([]AppTerraformWorkspace, error) {
+	var out []AppTerraformWorkspace
+	err := c.do(ctx, "GET", "/v1/terraform-workspaces", nil, nil, nil, &out)
+	return out, err
+}
+
+// GetUserJourneys calls GET /v1/account/user-journeys.
+//
+// Get user journeys
+func (c *Client) GetUserJourneys(ctx context.Context) ([]AppUserJourney, error) {
+	var out []AppUserJourney
+	err := c.do(ctx, "GET", "/v1/account/user-journeys", nil, nil, nil, &out)
+	return out, err
+}
+
+// GetV1VCSConnectionsConnectionIDRepos calls GET /v1/vcs/connections/{connection_id}/repos.
+//
+// List VCS connection repositories
+func (c *Client) GetV1VCSConnectionsConnectionIDRepos(ctx context.Context, connectionID string) (*ServiceVCSConnectionReposResponse, error) {
+	var out ServiceVCSConnectionReposResponse
+	if err := c.do(ctx, "GET", "/v1/vcs/connections/{connection_id}/repos", map[string]string{"connection_id": connectionID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetVCSConnection calls GET /v1/vcs/connections/{connection_id}.
+//
+// returns a vcs connection for an org
+func (c *Client) GetVCSConnection(ctx context.Context, connectionID string) (*AppVCSConnection, error) {
+	var out AppVCSConnection
+	if err := c.do(ctx, "GET", "/v1/vcs/connections/{connection_id}", map[string]string{"connection_id": connectionID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetWorkflow calls GET /v1/workflows/{workflow_id}.
+//
+// get a workflow
+func (c *Client) GetWorkflow(ctx context.Context, workflowID string) (*AppWorkflow, error) {
+	var out AppWorkflow
+	if err := c.do(ctx, "GET", "/v1/workflows/{workflow_id}", map[string]string{"workflow_id": workflowID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetWorkflowStep calls GET /v1/workflows/{workflow_id}/steps/{step_id}.
+//
+// get a workflow step
+func (c *Client) GetWorkflowStep(ctx context.Context, workflowID string, stepID string) (*AppWorkflowStep, error) {
+	var out AppWorkflowStep
+	if err := c.do(ctx, "GET", "/v1/workflows/{workflow_id}/steps/{step_id}", map[string]string{"workflow_id": workflowID, "step_id": stepID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetWorkflowStepApproval calls GET /v1/workflows/{workflow_id}/steps/{step_id}/approvals/{approval_id}.
+//
+// get an workflow step approval
+func (c *Client) GetWorkflowStepApproval(ctx context.Context, workflowID string, stepID string, approvalID string) (*AppWorkflowStepApproval, error) {
+	var out AppWorkflowStepApproval
+	if err := c.do(ctx, "GET", "/v1/workflows/{workflow_id}/steps/{step_id}/approvals/{approval_id}", map[string]string{"workflow_id": workflowID, "step_id": stepID, "approval_id": approvalID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetWorkflowStepApprovalContents calls GET /v1/workflows/{workflow_id}/steps/{step_id}/approvals/{approval_id}/contents.
+//
+// get a workflow step approval contents
+func (c *Client) GetWorkflowStepApprovalContents(ctx context.Context, workflowID string, stepID string, approvalID string) error {
+	return c.do(ctx, "GET", "/v1/workflows/{workflow_id}/steps/{step_id}/approvals/{approval_id}/contents", map[string]string{"workflow_id": workflowID, "step_id": stepID, "approval_id": approvalID}, nil, nil, nil)
+}
+
+// GetWorkflowSteps calls GET /v1/workflows/{workflow_id}/steps.
+//
+// get all of the steps for a given workflow
+func (c *Client) GetWorkflowSteps(ctx context.Context, workflowID string) ([]AppWorkflowStep, error) {
+	var out []AppWorkflowStep
+	err := c.do(ctx, "GET", "/v1/workflows/{workflow_id}/steps", map[string]string{"workflow_id": workflowID}, nil, nil, &out)
+	return out, err
+}
+
+// GetWorkflowsParams holds the query parameters of GET /v1/installs/{install_id}/workflows. Zero values are not sent.
+type GetWorkflowsParams struct {
+	// offset of results to return
+	Offset int64
+	// limit of results to return
+	Limit int64
+	// page number of results to return
+	Page int64
+	// exclude plan only workflows when set to false
+	Planonly bool
+	// filter by workflow type
+	Type string
+	// filter workflows created after timestamp (RFC3339 format)
+	CreatedAtGte string
+	// filter workflows created before timestamp (RFC3339 format)
+	CreatedAtLte string
+}
+
+func (p *GetWorkflowsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Offset != 0 {
+		q.Set("offset", strconv.FormatInt(p.Offset, 10))
+	}
+	if p.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(p.Limit, 10))
+	}
+	if p.Page != 0 {
+		q.Set("page", strconv.FormatInt(p.Page, 10))
+	}
+	if p.Planonly {
+		q.Set("planonly", "true")
+	}
+	if p.Type != "" {
+		q.Set("type", p.Type)
+	}
+	if p.CreatedAtGte != "" {
+		q.Set("created_at_gte", p.CreatedAtGte)
+	}
+	if p.CreatedAtLte != "" {
+		q.Set("created_at_lte", p.CreatedAtLte)
+	}
+	return q
+}
+
+// GetWorkflows calls GET /v1/installs/{install_id}/workflows.
+//
+// get workflows
+func (c *Client) GetWorkflows(ctx context.Context, installID string, params *GetWorkflowsParams) ([]AppWorkflow, error) {
+	var out []AppWorkflow
+	err := c.do(ctx, "GET", "/v1/installs/{install_id}/workflows", map[string]string{"install_id": installID}, params.values(), nil, &out)
+	return out, err
+}
+
+// GracefulShutDownRunner calls POST /v1/runners/{runner_id}/graceful-shutdown.
+//
+// shut down a runner
+func (c *Client) GracefulShutDownRunner(ctx context.Context, runnerID string, body any) (bool, error) {
+	var out bool
+	err := c.do(ctx, "POST", "/v1/runners/{runner_id}/graceful-shutdown", map[string]string{"runner_id": runnerID}, nil, body, &out)
+	return out, err
+}
+
+// LockTerraformWorkspaceParams holds the query parameters of POST /v1/terraform-workspaces/{workspace_id}/lock. Zero values are not sent.
+type LockTerraformWorkspaceParams struct {
+	// job ID
+	JobID string
+}
+
+func (p *LockTerraformWorkspaceParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.JobID != "" {
+		q.Set("job_id", p.JobID)
+	}
+	return q
+}
+
+// LockTerraformWorkspace calls POST /v1/terraform-workspaces/{workspace_id}/lock.
+//
+// lock terraform state
+func (c *Client) LockTerraformWorkspace(ctx context.Context, workspaceID string, body any, params *LockTerraformWorkspaceParams) (*AppTerraformWorkspaceState, error) {
+	var out AppTerraformWorkspaceState
+	if err := c.do(ctx, "POST", "/v1/terraform-workspaces/{workspace_id}/lock", map[string]string{"workspace_id": workspaceID}, params.values(), body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LogStreamReadLogsParams holds the query parameters of GET /v1/log-streams/{log_stream_id}/logs. Zero values are not sent.
+type LogStreamReadLogsParams struct {
+	// resource attribute filters
+	Order string
+}
+
+func (p *LogStreamReadLogsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Order != "" {
+		q.Set("order", p.Order)
+	}
+	return q
+}
+
+// LogStreamReadLogs calls GET /v1/log-streams/{log_stream_id}/logs.
+//
+// read a log stream's logs
+func (c *Client) LogStreamReadLogs(ctx context.Context, logStreamID string, params *LogStreamReadLogsParams) ([]AppOtelLogRecord, error) {
+	var out []AppOtelLogRecord
+	err := c.do(ctx, "GET", "/v1/log-streams/{log_stream_id}/logs", map[string]string{"log_stream_id": logStreamID}, params.values(), nil, &out)
+	return out, err
+}
+
+// MngVMShutDown calls POST /v1/runners/{runner_id}/mng/shutdown-vm.
+//
+// shut down an install runner VM
+func (c *Client) MngVMShutDown(ctx context.Context, runnerID string, body any) (bool, error) {
+	var out bool
+	err := c.do(ctx, "POST", "/v1/runners/{runner_id}/mng/shutdown-vm", map[string]string{"runner_id": runnerID}, nil, body, &out)
+	return out, err
+}
+
+// PhoneHome calls POST /v1/installs/{install_id}/phone-home/{phone_home_id}.
+//
+// phone home for an install
+func (c *Client) PhoneHome(ctx context.Context, installID string, phoneHomeID string, body any) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/phone-home/{phone_home_id}", map[string]string{"install_id": installID, "phone_home_id": phoneHomeID}, nil, body, &out)
+	return out, err
+}
+
+// PruneTokens calls POST /v1/runners/{runner_id}/prune-tokens.
+//
+// Prune old tokens for a runner
+func (c *Client) PruneTokens(ctx context.Context, runnerID string) (*ServicePruneTokensResponse, error) {
+	var out ServicePruneTokensResponse
+	if err := c.do(ctx, "POST", "/v1/runners/{runner_id}/prune-tokens", map[string]string{"runner_id": runnerID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveUser calls POST /v1/orgs/current/remove-user.
+//
+// Remove a user from the current org
+func (c *Client) RemoveUser(ctx context.Context, body *ServiceRemoveOrgUserRequest) (*AppAccount, error) {
+	var out AppAccount
+	if err := c.do(ctx, "POST", "/v1/orgs/current/remove-user", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReprovisionInstall calls POST /v1/installs/{install_id}/reprovision.
+//
+// reprovision an install
+func (c *Client) ReprovisionInstall(ctx context.Context, installID string, body *ServiceReprovisionInstallRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/reprovision", map[string]string{"install_id": installID}, nil, body, &out)
+	return out, err
+}
+
+// ReprovisionInstallSandbox calls POST /v1/installs/{install_id}/reprovision-sandbox.
+//
+// reprovision an install sandbox
+func (c *Client) ReprovisionInstallSandbox(ctx context.Context, installID string, body *ServiceReprovisionInstallSandboxRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/reprovision-sandbox", map[string]string{"install_id": installID}, nil, body, &out)
+	return out, err
+}
+
+// ResendOrgInvite calls POST /v1/orgs/current/invites/{invite_id}/resend.
+//
+// Resend an org invite
+func (c *Client) ResendOrgInvite(ctx context.Context, inviteID string) (*AppOrgInvite, error) {
+	var out AppOrgInvite
+	if err := c.do(ctx, "POST", "/v1/orgs/current/invites/{invite_id}/resend", map[string]string{"invite_id": inviteID}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResetUserJourney calls POST /v1/account/user-journeys/{journey_name}/reset.
+//
+// Reset user journey steps
+func (c *Client) ResetUserJourney(ctx context.Context, journeyName string) (*AppAccount, error) {
+	var out AppAccount
+	if err := c.do(ctx, "POST", "/v1/account/user-journeys/{journey_name}/reset", map[string]string{"journey_name": journeyName}, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RetryOwnerWorkflowByID calls POST /v1/workflows/{workflow_id}/retry.
+//
+// rerun the workflow steps starting from input step id, can be used to retry a failed step
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) RetryOwnerWorkflowByID(ctx context.Context, workflowID string, body *ServiceRetryWorkflowByIDRequest) (*ServiceRetryWorkflowByIDResponse, error) {
+	var out ServiceRetryWorkflowByIDResponse
+	if err := c.do(ctx, "POST", "/v1/workflows/{workflow_id}/retry", map[string]string{"workflow_id": workflowID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RetryWorkflow calls POST /v1/installs/{install_id}/retry-workflow.
+//
+// rerun the workflow steps starting from input step id, can be used to retry a failed step
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) RetryWorkflow(ctx context.Context, installID string, body *ServiceRetryWorkflowRequest) (*ServiceRetryWorkflowResponse, error) {
+	var out ServiceRetryWorkflowResponse
+	if err := c.do(ctx, "POST", "/v1/installs/{install_id}/retry-workflow", map[string]string{"install_id": installID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RetryWorkflowStep calls POST /v1/workflows/{workflow_id}/steps/{step_id}/retry.
+//
+// rerun the workflow steps starting from input step id, can be used to retry a failed step
+func (c *Client) RetryWorkflowStep(ctx context.Context, workflowID string, stepID string, body *ServiceRetryWorkflowStepRequest) (*ServiceRetryWorkflowByIDResponse, error) {
+	var out ServiceRetryWorkflowByIDResponse
+	if err := c.do(ctx, "POST", "/v1/workflows/{workflow_id}/steps/{step_id}/retry", map[string]string{"workflow_id": workflowID, "step_id": stepID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ShutDownRunnerMng calls POST /v1/runners/{runner_id}/mng/shutdown.
+//
+// shut down an install runner management process
+func (c *Client) ShutDownRunnerMng(ctx context.Context, runnerID string, body any) (bool, error) {
+	var out bool
+	err := c.do(ctx, "POST", "/v1/runners/{runner_id}/mng/shutdown", map[string]string{"runner_id": runnerID}, nil, body, &out)
+	return out, err
+}
+
+// SyncSecrets calls POST /v1/installs/{install_id}/sync-secrets.
+//
+// sync secrets install
+func (c *Client) SyncSecrets(ctx context.Context, installID string, body *ServiceSyncSecretsRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/sync-secrets", map[string]string{"install_id": installID}, nil, body, &out)
+	return out, err
+}
+
+// TeardownInstallComponent calls POST /v1/installs/{install_id}/components/{component_id}/teardown.
+//
+// teardown an install component
+func (c *Client) TeardownInstallComponent(ctx context.Context, installID string, componentID string, body *ServiceTeardownInstallComponentRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/components/{component_id}/teardown", map[string]string{"install_id": installID, "component_id": componentID}, nil, body, &out)
+	return out, err
+}
+
+// TeardownInstallComponents calls POST /v1/installs/{install_id}/components/teardown-all.
+//
+// teardown an install's components
+func (c *Client) TeardownInstallComponents(ctx context.Context, installID string, body *ServiceTeardownInstallComponentsRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/installs/{install_id}/components/teardown-all", map[string]string{"install_id": installID}, nil, body, &out)
+	return out, err
+}
+
+// UnlockTerraformWorkspace calls POST /v1/terraform-workspaces/{workspace_id}/unlock.
+//
+// unlock terraform workspace
+func (c *Client) UnlockTerraformWorkspace(ctx context.Context, workspaceID string, body any) (*AppTerraformWorkspaceState, error) {
+	var out AppTerraformWorkspaceState
+	if err := c.do(ctx, "POST", "/v1/terraform-workspaces/{workspace_id}/unlock", map[string]string{"workspace_id": workspaceID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateApp calls PATCH /v1/apps/{app_id}.
+//
+// update an app
+func (c *Client) UpdateApp(ctx context.Context, appID string, body *ServiceUpdateAppRequest) (*AppApp, error) {
+	var out AppApp
+	if err := c.do(ctx, "PATCH", "/v1/apps/{app_id}", map[string]string{"app_id": appID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAppAction calls PATCH /v1/apps/{app_id}/actions/{action_id}.
+//
+// patch an app action
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) UpdateAppAction(ctx context.Context, appID string, actionID string, body *ServiceUpdateActionWorkflowRequest) (*AppActionWorkflow, error) {
+	var out AppActionWorkflow
+	if err := c.do(ctx, "PATCH", "/v1/apps/{app_id}/actions/{action_id}", map[string]string{"app_id": appID, "action_id": actionID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAppActionWorkflow calls PATCH /v1/action-workflows/{action_workflow_id}.
+//
+// patch an app
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) UpdateAppActionWorkflow(ctx context.Context, actionWorkflowID string, body *ServiceUpdateActionWorkflowRequest) (*AppActionWorkflow, error) {
+	var out AppActionWorkflow
+	if err := c.do(ctx, "PATCH", "/v1/action-workflows/{action_workflow_id}", map[string]string{"action_workflow_id": actionWorkflowID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAppComponent calls PATCH /v1/apps/{app_id}/components/{component_id}.
+//
+// update a component
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) UpdateAppComponent(ctx context.Context, appID string, componentID string, body *ServiceUpdateComponentRequest) (*AppComponent, error) {
+	var out AppComponent
+	if err := c.do(ctx, "PATCH", "/v1/apps/{app_id}/components/{component_id}", map[string]string{"app_id": appID, "component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAppConfig calls PATCH /v1/apps/{app_id}/config/{app_config_id}.
+//
+// PATCH /v1/apps/{app_id}/config/{app_config_id}
+func (c *Client) UpdateAppConfig(ctx context.Context, appID string, appConfigID string, body *ServiceUpdateAppConfigRequest) (*AppAppConfig, error) {
+	var out AppAppConfig
+	if err := c.do(ctx, "PATCH", "/v1/apps/{app_id}/config/{app_config_id}", map[string]string{"app_id": appID, "app_config_id": appConfigID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAppConfigInstalls calls POST /v1/apps/{app_id}/config/{app_config_id}/update-installs.
+//
+// POST /v1/apps/{app_id}/config/{app_config_id}/update-installs
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) UpdateAppConfigInstalls(ctx context.Context, appID string, appConfigID string, body *ServiceUpdateAppConfigInstallsRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/apps/{app_id}/config/{app_config_id}/update-installs", map[string]string{"app_id": appID, "app_config_id": appConfigID}, nil, body, &out)
+	return out, err
+}
+
+// UpdateAppConfigInstallsV2 calls POST /v1/apps/{app_id}/configs/{config_id}/update-installs.
+//
+// POST /v1/apps/{app_id}/configs/{config_id}/update-installs
+func (c *Client) UpdateAppConfigInstallsV2(ctx context.Context, appID string, configID string, body *ServiceUpdateAppConfigInstallsRequest) (string, error) {
+	var out string
+	err := c.do(ctx, "POST", "/v1/apps/{app_id}/configs/{config_id}/update-installs", map[string]string{"app_id": appID, "config_id": configID}, nil, body, &out)
+	return out, err
+}
+
+// UpdateAppConflgV2 calls PATCH /v1/apps/{app_id}/configs/{config_id}.
+//
+// PATCH /v1/apps/{app_id}/configs/{config_id}
+func (c *Client) UpdateAppConflgV2(ctx context.Context, appID string, configID string, body *ServiceUpdateAppConfigRequest) (*AppAppConfig, error) {
+	var out AppAppConfig
+	if err := c.do(ctx, "PATCH", "/v1/apps/{app_id}/configs/{config_id}", map[string]string{"app_id": appID, "config_id": configID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateComponent calls PATCH /v1/components/{component_id}.
+//
+// update a component
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) UpdateComponent(ctx context.Context, componentID string, body *ServiceUpdateComponentRequest) (*AppComponent, error) {
+	var out AppComponent
+	if err := c.do(ctx, "PATCH", "/v1/components/{component_id}", map[string]string{"component_id": componentID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateInstall calls PATCH /v1/installs/{install_id}.
+//
+// update an install
+func (c *Client) UpdateInstall(ctx context.Context, installID string, body *ServiceUpdateInstallRequest) (*AppInstall, error) {
+	var out AppInstall
+	if err := c.do(ctx, "PATCH", "/v1/installs/{install_id}", map[string]string{"install_id": installID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateInstallConfig calls PATCH /v1/installs/{install_id}/configs/{config_id}.
+//
+// update an install config
+func (c *Client) UpdateInstallConfig(ctx context.Context, installID string, configID string, body *ServiceUpdateInstallConfigRequest) (*AppInstallConfig, error) {
+	var out AppInstallConfig
+	if err := c.do(ctx, "PATCH", "/v1/installs/{install_id}/configs/{config_id}", map[string]string{"install_id": installID, "config_id": configID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateInstallInputs calls PATCH /v1/installs/{install_id}/inputs.
+//
+// Updates install input config for app
+func (c *Client) UpdateInstallInputs(ctx context.Context, installID string, body *ServiceUpdateInstallInputsRequest) (*AppInstallInputs, error) {
+	var out AppInstallInputs
+	if err := c.do(ctx, "PATCH", "/v1/installs/{install_id}/inputs", map[string]string{"install_id": installID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateInstallWorkflow calls PATCH /v1/install-workflows/{install_workflow_id}.
+//
+// update an install workflow
+//
+// Deprecated: the endpoint is deprecated in the API.
+func (c *Client) UpdateInstallWorkflow(ctx context.Context, installWorkflowID string, body *ServiceUpdateWorkflowRequest) (*AppWorkflow, error) {
+	var out AppWorkflow
+	if err := c.do(ctx, "PATCH", "/v1/install-workflows/{install_workflow_id}", map[string]string{"install_workflow_id": installWorkflowID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateOrg calls PATCH /v1/orgs/current.
+//
+// Update current org
+func (c *Client) UpdateOrg(ctx context.Context, body *ServiceUpdateOrgRequest) (*AppOrg, error) {
+	var out AppOrg
+	if err := c.do(ctx, "PATCH", "/v1/orgs/current", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateOrgFeatures calls PATCH /v1/orgs/current/features.
+//
+// update org features (requires user-managed-features flag)
+func (c *Client) UpdateOrgFeatures(ctx context.Context, body *ServiceUpdateOrgFeaturesRequest) (*AppOrg, error) {
+	var out AppOrg
+	if err := c.do(ctx, "PATCH", "/v1/orgs/current/features", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateRunnerMng calls POST /v1/runners/{runner_id}/mng/update.
+//
+// update an install runner via the mng process
+func (c *Client) UpdateRunnerMng(ctx context.Context, runnerID string, body any) (bool, error) {
+	var out bool
+	err := c.do(ctx, "POST", "/v1/runners/{runner_id}/mng/update", map[string]string{"runner_id": runnerID}, nil, body, &out)
+	return out, err
+}
+
+// UpdateRunnerSettings calls PATCH /v1/runners/{runner_id}/settings.
+//
+// update a runner's settings via its runner settings group
+func (c *Client) UpdateRunnerSettings(ctx context.Context, runnerID string, body *ServiceUpdateRunnerSettingsRequest) (*AppRunnerJobExecution, error) {
+	var out AppRunnerJobExecution
+	if err := c.do(ctx, "PATCH", "/v1/runners/{runner_id}/settings", map[string]string{"runner_id": runnerID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTerraformState calls POST /v1/terraform-backend.
+//
+// update terraform state
+func (c *Client) UpdateTerraformState(ctx context.Context, body any) (*AppTerraformWorkspaceState, error) {
+	var out AppTerraformWorkspaceState
+	if err := c.do(ctx, "POST", "/v1/terraform-backend", nil, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateUserJourneyStep calls PATCH /v1/account/user-journeys/{journey_name}/steps/{step_name}.
+//
+// Update user journey step completion status
+func (c *Client) UpdateUserJourneyStep(ctx context.Context, journeyName string, stepName string, body *ServiceUpdateUserJourneyStepRequest) (*AppAccount, error) {
+	var out AppAccount
+	if err := c.do(ctx, "PATCH", "/v1/account/user-journeys/{journey_name}/steps/{step_name}", map[string]string{"journey_name": journeyName, "step_name": stepName}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateWorkflow calls PATCH /v1/workflows/{workflow_id}.
+//
+// update a workflow
+func (c *Client) UpdateWorkflow(ctx context.Context, workflowID string, body *ServiceUpdateWorkflowRequest) (*AppWorkflow, error) {
+	var out AppWorkflow
+	if err := c.do(ctx, "PATCH", "/v1/workflows/{workflow_id}", map[string]string{"workflow_id": workflowID}, nil, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}